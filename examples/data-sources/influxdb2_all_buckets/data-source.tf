@@ -0,0 +1,12 @@
+data "influxdb2_all_buckets" "all" {
+  concurrency = 8
+}
+
+check "finite_retention" {
+  assert {
+    condition = alltrue([
+      for b in data.influxdb2_all_buckets.all.buckets : b.has_finite_retention
+    ])
+    error_message = "Every bucket must have a finite retention period."
+  }
+}
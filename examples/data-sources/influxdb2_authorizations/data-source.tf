@@ -0,0 +1,11 @@
+data "influxdb2_authorizations" "ci" {
+  user_name = "ci"
+}
+
+# Fail when the CI user has more active tokens than expected
+check "ci_token_sprawl" {
+  assert {
+    condition     = length([for a in data.influxdb2_authorizations.ci.authorizations : a if a.status == "active"]) <= 2
+    error_message = "The ci user has more than 2 active tokens."
+  }
+}
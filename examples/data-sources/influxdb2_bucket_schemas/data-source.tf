@@ -0,0 +1,16 @@
+data "influxdb2_buckets" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+data "influxdb2_bucket_schemas" "sensors" {
+  org_id    = data.influxdb2_organization.initial.id
+  bucket_id = data.influxdb2_buckets.all.ids["sensors"]
+}
+
+# The declared fields of each measurement, by measurement
+output "fields" {
+  value = {
+    for s in data.influxdb2_bucket_schemas.sensors.schemas :
+    s.name => { for c in s.columns : c.name => c.data_type if c.type == "field" }
+  }
+}
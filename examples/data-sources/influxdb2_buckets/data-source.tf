@@ -0,0 +1,15 @@
+data "influxdb2_buckets" "metrics" {
+  org_id      = data.influxdb2_organization.initial.id
+  name_prefix = "metrics-"
+}
+
+# Map every metrics bucket for InfluxQL clients
+resource "influxdb2_dbrp" "metrics" {
+  for_each = data.influxdb2_buckets.metrics.ids
+
+  org_id           = data.influxdb2_organization.initial.id
+  bucket_id        = each.value
+  database         = each.key
+  retention_policy = "autogen"
+  default          = true
+}
@@ -0,0 +1,9 @@
+# A check created by a template
+data "influxdb2_check" "cpu" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "CPU usage"
+}
+
+output "cpu_check_schedule" {
+  value = data.influxdb2_check.cpu.every
+}
@@ -0,0 +1,17 @@
+data "influxdb2_check" "cpu" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "CPU usage"
+}
+
+data "influxdb2_check_statuses" "cpu" {
+  org_id      = data.influxdb2_organization.initial.id
+  check_id    = data.influxdb2_check.cpu.id
+  range_start = "-15m"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.statuses) > 0
+      error_message = "The CPU usage check hasn't written any status in the last 15 minutes."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "influxdb2_checks" "critical" {
+  org_id = data.influxdb2_organization.initial.id
+  labels = ["critical"]
+}
+
+output "critical_checks" {
+  value = { for c in data.influxdb2_checks.critical.checks : c.name => c.status }
+}
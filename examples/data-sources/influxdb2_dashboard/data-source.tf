@@ -0,0 +1,9 @@
+# A Dashboard created by a template
+data "influxdb2_dashboard" "system" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "System"
+}
+
+output "system_dashboard_views" {
+  value = [for c in data.influxdb2_dashboard.system.cells : c.name]
+}
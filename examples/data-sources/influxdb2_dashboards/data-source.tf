@@ -0,0 +1,8 @@
+data "influxdb2_dashboards" "team" {
+  org_id = data.influxdb2_organization.initial.id
+  labels = ["team-a"]
+}
+
+output "team_dashboard_ids" {
+  value = data.influxdb2_dashboards.team.ids
+}
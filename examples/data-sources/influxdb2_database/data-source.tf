@@ -0,0 +1,3 @@
+data "influxdb2_database" "metrics" {
+  name = "metrics"
+}
@@ -0,0 +1,13 @@
+data "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+# The bucket backing the default retention policy of the legacy "telegraf" database
+data "influxdb2_dbrp" "telegraf" {
+  org_id   = data.influxdb2_organization.org.id
+  database = "telegraf"
+}
+
+output "telegraf_bucket_id" {
+  value = data.influxdb2_dbrp.telegraf.bucket_id
+}
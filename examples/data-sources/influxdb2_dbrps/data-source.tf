@@ -0,0 +1,8 @@
+data "influxdb2_dbrps" "telegraf" {
+  org_id   = data.influxdb2_organization.initial.id
+  database = "telegraf"
+}
+
+output "telegraf_retention_policies" {
+  value = { for m in data.influxdb2_dbrps.telegraf.dbrps : m.retention_policy => m.bucket_id }
+}
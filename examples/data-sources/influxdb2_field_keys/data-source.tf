@@ -0,0 +1,9 @@
+data "influxdb2_field_keys" "cpu" {
+  org_id      = data.influxdb2_organization.initial.id
+  bucket      = "telegraf"
+  measurement = "cpu"
+}
+
+output "cpu_float_fields" {
+  value = [for f, t in data.influxdb2_field_keys.cpu.types : f if t == "float"]
+}
@@ -0,0 +1,13 @@
+data "influxdb2_flags" "server" {}
+
+locals {
+  # flags are strings, or JSON encoded for other types
+  annotations_enabled = lookup(data.influxdb2_flags.server.flags, "annotations", "false") == "true"
+}
+
+resource "influxdb2_annotation_stream" "deploys" {
+  count = local.annotations_enabled ? 1 : 0
+
+  org_id = data.influxdb2_organization.initial.id
+  name   = "deploys"
+}
@@ -0,0 +1,22 @@
+data "influxdb2_flux_query" "measurements" {
+  org_id = data.influxdb2_organization.initial.id
+  query  = <<EOT
+import "influxdata/influxdb/schema"
+
+schema.measurements(bucket: "telegraf")
+EOT
+}
+
+data "influxdb2_buckets" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+# A DBRP mapping for every measurement found
+resource "influxdb2_dbrp" "measurements" {
+  for_each = toset([for r in data.influxdb2_flux_query.measurements.rows : r["_value"]])
+
+  org_id           = data.influxdb2_organization.initial.id
+  bucket_id        = data.influxdb2_buckets.all.ids["telegraf"]
+  database         = each.key
+  retention_policy = "autogen"
+}
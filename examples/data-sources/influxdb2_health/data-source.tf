@@ -0,0 +1,6 @@
+# Fails the plan with a clear message when the server is unhealthy
+data "influxdb2_health" "server" {}
+
+output "influxdb_version" {
+  value = data.influxdb2_health.server.version
+}
@@ -0,0 +1,5 @@
+# A label created by a template
+data "influxdb2_label" "production" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "production"
+}
@@ -0,0 +1,7 @@
+data "influxdb2_labels" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+output "label_colors" {
+  value = { for l in data.influxdb2_labels.all.labels : l.name => l.color }
+}
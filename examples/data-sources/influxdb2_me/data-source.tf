@@ -0,0 +1,10 @@
+data "influxdb2_me" "current" {}
+
+resource "influxdb2_organization" "team" {
+  name = "team"
+}
+
+resource "influxdb2_org_owner" "applier" {
+  org_id  = influxdb2_organization.team.id
+  user_id = data.influxdb2_me.current.id
+}
@@ -0,0 +1,9 @@
+data "influxdb2_measurements" "telegraf" {
+  org_id      = data.influxdb2_organization.initial.id
+  bucket      = "telegraf"
+  range_start = "-7d"
+}
+
+output "telegraf_measurements" {
+  value = data.influxdb2_measurements.telegraf.measurements
+}
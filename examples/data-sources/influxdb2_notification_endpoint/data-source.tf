@@ -0,0 +1,8 @@
+data "influxdb2_notification_endpoint" "oncall" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "On-call PagerDuty"
+}
+
+output "oncall_endpoint_id" {
+  value = data.influxdb2_notification_endpoint.oncall.id
+}
@@ -0,0 +1,4 @@
+data "influxdb2_notification_endpoint_health" "slack" {
+  endpoint_id = "0a1b2c3d4e5f6a7b"
+  message     = "Alert channel check from the infrastructure pipeline"
+}
@@ -0,0 +1,7 @@
+data "influxdb2_notification_endpoints" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+output "on_call_endpoint_id" {
+  value = data.influxdb2_notification_endpoints.all.ids["on-call"]
+}
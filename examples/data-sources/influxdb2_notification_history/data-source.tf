@@ -0,0 +1,13 @@
+data "influxdb2_notification_rules" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+data "influxdb2_notification_history" "critical" {
+  org_id      = data.influxdb2_organization.initial.id
+  rule_id     = data.influxdb2_notification_rules.all.ids["Critical alerts"]
+  range_start = "-1d"
+}
+
+output "critical_alerts_sent" {
+  value = data.influxdb2_notification_history.critical.sent_count
+}
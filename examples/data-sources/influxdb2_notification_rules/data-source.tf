@@ -0,0 +1,9 @@
+# The rules notifying the statuses of a check
+data "influxdb2_notification_rules" "cpu" {
+  org_id   = data.influxdb2_organization.initial.id
+  check_id = data.influxdb2_check.cpu.id
+}
+
+output "cpu_notified_endpoints" {
+  value = distinct([for r in data.influxdb2_notification_rules.cpu.notification_rules : r.endpoint_id])
+}
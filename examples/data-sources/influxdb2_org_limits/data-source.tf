@@ -0,0 +1,10 @@
+data "influxdb2_org_limits" "limits" {
+  org_id = "0a1b2c3d4e5f6a7b"
+}
+
+check "room_for_team_buckets" {
+  assert {
+    condition     = data.influxdb2_org_limits.limits.max_buckets == 0 || data.influxdb2_org_limits.limits.max_buckets >= 10
+    error_message = "The Cloud plan of the Organization must allow at least 10 buckets."
+  }
+}
@@ -0,0 +1,8 @@
+data "influxdb2_org_members" "initial" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+# Owners granted outside of Terraform
+output "unmanaged_owners" {
+  value = setsubtract(data.influxdb2_org_members.initial.owner_ids, [for o in influxdb2_org_owner.owners : o.user_id])
+}
@@ -10,3 +10,17 @@ data "influxdb2_organization" "by_name" {
 data "influxdb2_organization" "by_id" {
   id = influxdb2_organization.org.id
 }
+
+# Optional Organizations can be looked up without failing the plan when they don't exist,
+# exists then tells whether they do, e.g. count = data.influxdb2_organization.optional.exists ? 1 : 0
+data "influxdb2_organization" "optional" {
+  name          = "maybe-org"
+  allow_missing = true
+}
+
+check "org_is_active" {
+  assert {
+    condition     = data.influxdb2_organization.by_id.is_active
+    error_message = "The Organization must be active."
+  }
+}
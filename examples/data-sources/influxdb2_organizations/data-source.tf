@@ -0,0 +1,11 @@
+data "influxdb2_organizations" "all" {
+}
+
+# Store the same secret in every Organization
+resource "influxdb2_secret" "slack_webhook" {
+  for_each = data.influxdb2_organizations.all.ids
+
+  org_id = each.value
+  key    = "SLACK_WEBHOOK"
+  value  = var.slack_webhook
+}
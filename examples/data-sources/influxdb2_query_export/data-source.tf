@@ -0,0 +1,15 @@
+data "influxdb2_organization" "org" {
+  name = "my-org"
+}
+
+data "influxdb2_query_export" "seeded" {
+  org_id      = data.influxdb2_organization.org.id
+  query       = <<-EOT
+    from(bucket: "config")
+      |> range(start: -30d)
+      |> filter(fn: (r) => r._measurement == "config")
+      |> last()
+  EOT
+  output_file = "${path.root}/artifacts/config-seeded.csv"
+  max_rows    = 100
+}
@@ -0,0 +1,14 @@
+data "influxdb2_ready" "server" {
+  min_version = "2.2.0"
+}
+
+# Replications need InfluxDB 2.2 or later
+resource "influxdb2_remote" "edge" {
+  count = data.influxdb2_ready.server.meets_min_version ? 1 : 0
+
+  org_id        = data.influxdb2_organization.initial.id
+  name          = "edge"
+  remote_url    = "https://cloud2.influxdata.com"
+  remote_token  = var.cloud_token
+  remote_org_id = var.cloud_org_id
+}
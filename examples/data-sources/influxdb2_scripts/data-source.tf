@@ -0,0 +1,5 @@
+data "influxdb2_scripts" "all" {}
+
+output "script_urls" {
+  value = { for s in data.influxdb2_scripts.all.scripts : s.name => s.url }
+}
@@ -0,0 +1,12 @@
+data "influxdb2_server_config" "current" {}
+
+resource "influxdb2_organization" "analytics" {
+  name = "analytics"
+
+  lifecycle {
+    precondition {
+      condition     = tonumber(data.influxdb2_server_config.current.config["query-concurrency"]) >= 10
+      error_message = "The server must run at least 10 concurrent queries."
+    }
+  }
+}
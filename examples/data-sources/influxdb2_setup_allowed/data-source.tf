@@ -0,0 +1,22 @@
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "operator_token" {
+  type      = string
+  sensitive = true
+}
+
+data "influxdb2_setup_allowed" "instance" {}
+
+# only onboard a fresh instance
+resource "influxdb2_setup" "setup" {
+  count = data.influxdb2_setup_allowed.instance.allowed ? 1 : 0
+
+  username = "admin"
+  password = var.admin_password
+  org      = "my-org"
+  bucket   = "my-bucket"
+  token    = var.operator_token
+}
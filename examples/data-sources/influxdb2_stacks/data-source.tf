@@ -0,0 +1,12 @@
+data "influxdb2_stacks" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+data "influxdb2_buckets" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+# The buckets that aren't managed by a stack
+output "unmanaged_bucket_ids" {
+  value = [for id in values(data.influxdb2_buckets.all.ids) : id if !contains(data.influxdb2_stacks.all.resource_ids, id)]
+}
@@ -0,0 +1,9 @@
+data "influxdb2_tag_keys" "cpu" {
+  org_id      = data.influxdb2_organization.initial.id
+  bucket      = "telegraf"
+  measurement = "cpu"
+}
+
+output "cpu_tags" {
+  value = data.influxdb2_tag_keys.cpu.tag_keys
+}
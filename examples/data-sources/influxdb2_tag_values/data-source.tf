@@ -0,0 +1,10 @@
+data "influxdb2_tag_values" "hosts" {
+  org_id = data.influxdb2_organization.initial.id
+  bucket = "telegraf"
+  tag    = "host"
+}
+
+# e.g. the values of a map variable
+output "hosts" {
+  value = { for h in data.influxdb2_tag_values.hosts.tag_values : h => h }
+}
@@ -0,0 +1,11 @@
+data "influxdb2_task_run_logs" "backfill" {
+  task_id = influxdb2_task_run.backfill.task_id
+  run_id  = influxdb2_task_run.backfill.run_id
+}
+
+check "backfill_succeeded" {
+  assert {
+    condition     = data.influxdb2_task_run_logs.backfill.status != "failed"
+    error_message = join("\n", data.influxdb2_task_run_logs.backfill.logs[*].message)
+  }
+}
@@ -0,0 +1,11 @@
+data "influxdb2_tasks" "active" {
+  org_id = data.influxdb2_organization.initial.id
+  status = "active"
+}
+
+output "failing_tasks" {
+  value = {
+    for t in data.influxdb2_tasks.active.tasks : t.name => t.last_run_error
+    if t.last_run_status == "failed"
+  }
+}
@@ -0,0 +1,14 @@
+data "influxdb2_telegraf_config" "system" {
+  org_id = data.influxdb2_organization.initial.id
+  name   = "system-metrics"
+}
+
+# Write the agent configuration on boot
+locals {
+  user_data = <<-EOT
+    #cloud-config
+    write_files:
+      - path: /etc/telegraf/telegraf.conf
+        content: ${jsonencode(data.influxdb2_telegraf_config.system.config)}
+  EOT
+}
@@ -0,0 +1,8 @@
+data "influxdb2_telegraf_configs" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+# The agents load their configuration from InfluxDB2
+output "telegraf_config_urls" {
+  value = { for name, id in data.influxdb2_telegraf_configs.all.ids : name => "http://localhost:8086/api/v2/telegrafs/${id}" }
+}
@@ -0,0 +1,21 @@
+data "influxdb2_dashboards" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+# Capture a dashboard created in the UI...
+data "influxdb2_template_export" "system" {
+  resource {
+    kind = "Dashboard"
+    id   = data.influxdb2_dashboards.all.ids["System"]
+  }
+}
+
+resource "influxdb2_organization" "staging" {
+  name = "staging"
+}
+
+# ...and apply it to another Organization
+resource "influxdb2_template_apply" "system" {
+  org_id   = influxdb2_organization.staging.id
+  template = data.influxdb2_template_export.system.yaml
+}
@@ -0,0 +1,8 @@
+data "influxdb2_user" "alice" {
+  name = "alice"
+}
+
+resource "influxdb2_org_owner" "alice" {
+  org_id  = influxdb2_organization.org.id
+  user_id = data.influxdb2_user.alice.id
+}
@@ -0,0 +1,11 @@
+data "influxdb2_users" "sre" {
+  name_prefix = "sre-"
+}
+
+# Make every SRE an owner of the production Organization
+resource "influxdb2_org_owner" "sre" {
+  for_each = data.influxdb2_users.sre.ids
+
+  org_id  = influxdb2_organization.production.id
+  user_id = each.value
+}
@@ -0,0 +1,7 @@
+data "influxdb2_variables" "all" {
+  org_id = data.influxdb2_organization.initial.id
+}
+
+output "query_variables" {
+  value = [for v in data.influxdb2_variables.all.variables : v.name if v.type == "query"]
+}
@@ -1,4 +1,20 @@
 provider "influxdb2" {
   host  = "http://localhost:8086"    # changeme
   token = "super-secret-admin-token" # changeme
+  # the Organization of the resources and data sources that don't set org_id
+  org = "my-org" # changeme
+}
+
+# Or, on InfluxDB2 OSS, with a session instead of a token
+provider "influxdb2" {
+  alias    = "session"
+  host     = "http://localhost:8086" # changeme
+  username = "admin"                 # changeme
+  password = "super-secret"          # changeme
+}
+
+# Or with the host, token & org of an influx CLI profile, see `influx config`
+provider "influxdb2" {
+  alias   = "cli"
+  profile = "default" # changeme
 }
@@ -0,0 +1,2 @@
+# Annotation streams are imported using the Organization ID and the stream ID.
+terraform import influxdb2_annotation_stream.deployments <org-id>/<stream-id>
@@ -0,0 +1,9 @@
+data "influxdb2_organization" "main" {
+  name = "main"
+}
+
+resource "influxdb2_annotation_stream" "deployments" {
+  org_id      = data.influxdb2_organization.main.id
+  name        = "deployments"
+  description = "Deployment markers written by CI pipelines"
+}
@@ -0,0 +1,8 @@
+# Back up the instance before upgrading it, whenever the target version changes
+resource "influxdb2_backup" "pre_upgrade" {
+  path = "${path.module}/backups/pre-upgrade"
+
+  triggers = {
+    version = var.influxdb_version
+  }
+}
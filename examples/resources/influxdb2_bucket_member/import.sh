@@ -0,0 +1,2 @@
+# Bucket members are imported using the bucket ID and the user ID.
+terraform import influxdb2_bucket_member.reader <bucket-id>/<user-id>
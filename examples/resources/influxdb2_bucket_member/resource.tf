@@ -0,0 +1,4 @@
+resource "influxdb2_bucket_member" "reader" {
+  bucket_id = "0a1b2c3d4e5f6a7b"
+  user_id   = "1b2c3d4e5f6a7b8c"
+}
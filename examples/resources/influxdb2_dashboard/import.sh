@@ -0,0 +1 @@
+terraform import influxdb2_dashboard.cpu <my-id>
@@ -0,0 +1,9 @@
+resource "influxdb2_organization" "org" {
+  name        = "test-org"
+  description = "Organization for test users"
+}
+
+resource "influxdb2_dashboard" "cpu" {
+  org_id    = influxdb2_organization.org.id
+  body_json = file("${path.module}/cpu_dashboard.json")
+}
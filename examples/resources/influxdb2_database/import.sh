@@ -0,0 +1,2 @@
+# Databases are imported using their name.
+terraform import influxdb2_database.metrics metrics
@@ -0,0 +1,31 @@
+provider "influxdb2" {
+  host  = "https://cluster-id.a.influxdb.io"
+  token = var.database_token
+
+  cloud_dedicated {
+    account_id = "11111111-1111-4111-8111-111111111111"
+    cluster_id = "22222222-2222-4222-8222-222222222222"
+    # management_token is read from INFLUX_MANAGEMENT_TOKEN
+  }
+}
+
+resource "influxdb2_database" "metrics" {
+  name                  = "metrics"
+  retention_period      = 30 * 24 * 3600
+  max_tables            = 500
+  max_columns_per_table = 200
+
+  partition_template {
+    type     = "tag"
+    tag_name = "region"
+  }
+  partition_template {
+    type              = "bucket"
+    tag_name          = "host"
+    number_of_buckets = 10
+  }
+  partition_template {
+    type        = "time"
+    time_format = "%Y-%m-%d"
+  }
+}
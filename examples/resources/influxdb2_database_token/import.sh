@@ -0,0 +1,2 @@
+# Database tokens are imported using their ID. The token itself is unknown once imported.
+terraform import influxdb2_database_token.metrics_writer <token-id>
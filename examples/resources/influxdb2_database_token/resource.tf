@@ -0,0 +1,17 @@
+resource "influxdb2_database_token" "metrics_writer" {
+  description = "Writes of the metrics pipeline"
+
+  permissions {
+    action   = "write"
+    database = influxdb2_database.metrics.name
+  }
+  permissions {
+    action   = "read"
+    database = influxdb2_database.metrics.name
+  }
+}
+
+output "metrics_writer_token" {
+  value     = influxdb2_database_token.metrics_writer.access_token
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# DBRP mappings are imported using the Organization ID and the mapping ID
+terraform import influxdb2_dbrp.telegraf <org-id>/<dbrp-id>
@@ -0,0 +1,11 @@
+data "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+resource "influxdb2_dbrp" "telegraf" {
+  org_id           = data.influxdb2_organization.org.id
+  bucket_id        = "0123456789abcdef"
+  database         = "telegraf"
+  retention_policy = "autogen"
+  default          = true
+}
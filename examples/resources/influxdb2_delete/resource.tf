@@ -0,0 +1,11 @@
+resource "influxdb2_delete" "gdpr_user_42" {
+  org_id    = "0a1b2c3d4e5f6a7b"
+  bucket_id = "1b2c3d4e5f6a7b8c"
+  predicate = "_measurement=\"users\" AND user_id=\"42\""
+  start     = "1970-01-01T00:00:00Z"
+  stop      = "2021-06-01T00:00:00Z"
+
+  triggers = {
+    ticket = "GDPR-1234"
+  }
+}
@@ -0,0 +1,12 @@
+resource "influxdb2_environment" "checkout_staging" {
+  name              = "checkout-staging"
+  description       = "Staging environment of the checkout service"
+  bucket_name       = "metrics"
+  retention_period  = 30 * 24 * 3600
+  token_description = "checkout-staging writer"
+}
+
+output "checkout_staging_token" {
+  value     = influxdb2_environment.checkout_staging.token
+  sensitive = true
+}
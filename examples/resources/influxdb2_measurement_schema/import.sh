@@ -0,0 +1,2 @@
+# Measurement schemas are imported using the bucket ID and the schema ID.
+terraform import influxdb2_measurement_schema.cpu <bucket-id>/<schema-id>
@@ -0,0 +1,18 @@
+resource "influxdb2_measurement_schema" "cpu" {
+  bucket_id = "0a1b2c3d4e5f6a7b"
+  name      = "cpu"
+
+  columns {
+    name = "time"
+    type = "timestamp"
+  }
+  columns {
+    name = "host"
+    type = "tag"
+  }
+  columns {
+    name      = "usage_user"
+    type      = "field"
+    data_type = "float"
+  }
+}
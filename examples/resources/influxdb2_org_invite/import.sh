@@ -0,0 +1,2 @@
+# Pending invites are imported using the Organization ID and the invite ID.
+terraform import influxdb2_org_invite.jane <org-id>/<invite-id>
@@ -0,0 +1,22 @@
+resource "influxdb2_org_invite" "jane" {
+  org_id = "0a1b2c3d4e5f6a7b"
+  email  = "jane@example.com"
+  role   = "member"
+}
+
+# Onboard a team from the same configuration as its buckets & tokens
+variable "team" {
+  type = map(string)
+  default = {
+    "alice@example.com" = "owner"
+    "bob@example.com"   = "member"
+  }
+}
+
+resource "influxdb2_org_invite" "team" {
+  for_each = var.team
+
+  org_id = "0a1b2c3d4e5f6a7b"
+  email  = each.key
+  role   = each.value
+}
@@ -0,0 +1,2 @@
+# Org owners are imported using the Organization ID and the user ID.
+terraform import influxdb2_org_owner.admin <org-id>/<user-id>
@@ -0,0 +1,8 @@
+resource "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+resource "influxdb2_org_owner" "admin" {
+  org_id  = influxdb2_organization.org.id
+  user_id = "0a1b2c3d4e5f6a7b"
+}
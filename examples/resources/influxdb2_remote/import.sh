@@ -0,0 +1,3 @@
+# Remotes are imported using their ID. The remote token isn't returned by the API, so
+# it is only set in state on the next apply.
+terraform import influxdb2_remote.cloud <remote-id>
@@ -0,0 +1,17 @@
+variable "cloud_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "influxdb2_organization" "edge" {
+  name = "edge"
+}
+
+resource "influxdb2_remote" "cloud" {
+  org_id        = influxdb2_organization.edge.id
+  name          = "cloud"
+  description   = "Replication target in InfluxDB Cloud"
+  remote_url    = "https://us-west-2-1.aws.cloud2.influxdata.com"
+  remote_org_id = "0a1b2c3d4e5f6a7b"
+  remote_token  = var.cloud_token
+}
@@ -0,0 +1,9 @@
+# Re-create the buckets of the latest backup on a new instance
+resource "influxdb2_restore" "metrics" {
+  path         = "${path.module}/backups/pre-upgrade"
+  bucket_names = ["metrics", "logs"]
+}
+
+output "restored_metrics_bucket_id" {
+  value = influxdb2_restore.metrics.bucket_ids["metrics"]
+}
@@ -0,0 +1,24 @@
+# Rotate the token every 90 days, using the time provider
+resource "time_rotating" "operator" {
+  rotation_days = 90
+}
+
+resource "influxdb2_rotating_token" "operator" {
+  org_id      = data.influxdb2_organization.initial.id
+  access      = "operator"
+  description = "Operator token of the platform team"
+
+  rotation_keepers = {
+    rotation = time_rotating.operator.id
+  }
+
+  # create the new token before the old one is deleted
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "operator_token" {
+  value     = influxdb2_rotating_token.operator.token
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Scripts are imported using their ID.
+terraform import influxdb2_script.cpu <script-id>
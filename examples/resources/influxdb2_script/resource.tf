@@ -0,0 +1,10 @@
+resource "influxdb2_script" "cpu" {
+  name        = "cpu-last-hour"
+  description = "Mean CPU usage of a host over the last hour"
+  script      = <<-EOT
+    from(bucket: params.bucket)
+      |> range(start: -1h)
+      |> filter(fn: (r) => r._measurement == "cpu" and r.host == params.host)
+      |> mean()
+  EOT
+}
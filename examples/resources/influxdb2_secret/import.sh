@@ -0,0 +1,3 @@
+# Secrets are imported using the Organization ID and the secret key. The value
+# isn't returned by the API, so it is only set in state on the next apply.
+terraform import influxdb2_secret.slack <org-id>/SLACK_TOKEN
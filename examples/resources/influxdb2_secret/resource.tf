@@ -0,0 +1,14 @@
+resource "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+variable "slack_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "influxdb2_secret" "slack" {
+  org_id = influxdb2_organization.org.id
+  key    = "SLACK_TOKEN"
+  value  = var.slack_token
+}
@@ -0,0 +1,23 @@
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "operator_token" {
+  type      = string
+  sensitive = true
+}
+
+provider "influxdb2" {
+  host  = "http://localhost:8086"
+  token = var.operator_token
+}
+
+resource "influxdb2_setup" "setup" {
+  username         = "admin"
+  password         = var.admin_password
+  org              = "my-org"
+  bucket           = "my-bucket"
+  retention_period = 2592000
+  token            = var.operator_token
+}
@@ -0,0 +1,3 @@
+# Sources are imported using their ID. The password, token and shared secret aren't
+# returned by the API, so they are only set in state on the next apply.
+terraform import influxdb2_source.legacy <source-id>
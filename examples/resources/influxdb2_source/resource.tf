@@ -0,0 +1,19 @@
+variable "influxdb1_password" {
+  type      = string
+  sensitive = true
+}
+
+data "influxdb2_organization" "main" {
+  name = "main"
+}
+
+resource "influxdb2_source" "legacy" {
+  org_id     = data.influxdb2_organization.main.id
+  name       = "legacy-influxdb"
+  type       = "v1"
+  url        = "http://influxdb1.internal:8086"
+  username   = "chronograf"
+  password   = var.influxdb1_password
+  telegraf   = "telegraf"
+  default_rp = "autogen"
+}
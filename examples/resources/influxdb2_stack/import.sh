@@ -0,0 +1,2 @@
+# Stacks are imported using the Organization ID and the stack ID.
+terraform import influxdb2_stack.monitoring <org-id>/<stack-id>
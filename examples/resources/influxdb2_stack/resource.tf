@@ -0,0 +1,12 @@
+resource "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+resource "influxdb2_stack" "monitoring" {
+  org_id      = influxdb2_organization.org.id
+  name        = "docker-monitoring"
+  description = "Docker monitoring community template"
+  urls = [
+    "https://raw.githubusercontent.com/influxdata/community-templates/master/docker/docker.yml",
+  ]
+}
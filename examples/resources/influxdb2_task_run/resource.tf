@@ -0,0 +1,14 @@
+# Backfill the downsampling task for the last week whenever its query changes
+resource "influxdb2_task_run" "backfill" {
+  task_id             = var.downsampling_task_id
+  scheduled_for       = "2021-06-01T00:00:00Z"
+  wait_for_completion = true
+
+  triggers = {
+    flux = sha1(var.downsampling_flux)
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -0,0 +1,3 @@
+# Applied templates are imported using the Organization ID and the ID of the stack
+# tracking their resources.
+terraform import influxdb2_template_apply.docker <org-id>/<stack-id>
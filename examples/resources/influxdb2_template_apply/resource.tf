@@ -0,0 +1,19 @@
+resource "influxdb2_organization" "org" {
+  name = "test-org"
+}
+
+# A local template file
+resource "influxdb2_template_apply" "monitoring" {
+  org_id   = influxdb2_organization.org.id
+  template = file("${path.module}/templates/monitoring.yml")
+
+  env_refs = {
+    bucket = "telegraf"
+  }
+}
+
+# A community template
+resource "influxdb2_template_apply" "docker" {
+  org_id       = influxdb2_organization.org.id
+  template_url = "https://raw.githubusercontent.com/influxdata/community-templates/master/docker/docker.yml"
+}
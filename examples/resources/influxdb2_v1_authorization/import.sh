@@ -0,0 +1,3 @@
+# V1 authorizations are imported using their ID. The password isn't returned by the API,
+# so it is only set in state on the next apply.
+terraform import influxdb2_v1_authorization.grafana <authorization-id>
@@ -0,0 +1,30 @@
+variable "grafana_password" {
+  type      = string
+  sensitive = true
+}
+
+data "influxdb2_organization" "main" {
+  name = "main"
+}
+
+resource "influxdb2_dbrp" "telegraf" {
+  org_id           = data.influxdb2_organization.main.id
+  bucket_id        = "0a1b2c3d4e5f6a7b"
+  database         = "telegraf"
+  retention_policy = "autogen"
+  default          = true
+}
+
+resource "influxdb2_v1_authorization" "grafana" {
+  org_id      = data.influxdb2_organization.main.id
+  username    = "grafana"
+  password    = var.grafana_password
+  description = "Grafana InfluxQL data source"
+
+  permissions {
+    action = "read"
+    type   = "buckets"
+    id     = influxdb2_dbrp.telegraf.bucket_id
+    org_id = data.influxdb2_organization.main.id
+  }
+}
@@ -0,0 +1,24 @@
+data "influxdb2_organization" "main" {
+  name = "main"
+}
+
+# Seed reference data from a local file; changing the file re-writes it
+resource "influxdb2_write" "regions" {
+  org_id      = data.influxdb2_organization.main.id
+  bucket_id   = "0a1b2c3d4e5f6a7b"
+  source_file = "${path.module}/regions.lp"
+  precision   = "s"
+}
+
+# Smoke test a bucket with a point escaped as needed
+resource "influxdb2_write" "smoke_test" {
+  org_id    = data.influxdb2_organization.main.id
+  bucket_id = "0a1b2c3d4e5f6a7b"
+
+  point {
+    measurement   = "terraform"
+    tags          = { workspace = terraform.workspace }
+    fields        = { applied = "true" }
+    string_fields = { note = "provisioned by Terraform" }
+  }
+}
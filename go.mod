@@ -14,4 +14,5 @@ require (
 	github.com/posener/complete v1.2.1 // indirect
 	golang.org/x/tools v0.0.0-20201028111035-eafbe7b904eb // indirect
 	google.golang.org/api v0.34.0 // indirect
+	gopkg.in/yaml.v2 v2.3.0
 )
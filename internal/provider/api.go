@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// apiClient talks to the InfluxDB2 HTTP API directly. It is used for the endpoints
+// that influxdb-client-go doesn't wrap, and in places where the provider needs to see
+// the raw response (status codes, headers) rather than a pre-digested error.
+type apiClient struct {
+	host  string
+	token string
+	// authScheme is the scheme of the Authorization header, `Token` for the InfluxDB2 API.
+	authScheme string
+	// username & password are set instead of token for session authentication, see signin.
+	username   string
+	password   string
+	httpClient *http.Client
+	// maxRetries & maxBackoff bound the retries of transient errors, see isRetryable.
+	maxRetries int
+	maxBackoff time.Duration
+	// userAgent is the User-Agent header of every request, the Go default when empty.
+	userAgent string
+	// headers are added to every request, e.g. for the authentication of a gateway in front
+	// of InfluxDB2.
+	headers map[string]string
+	// limiter throttles the requests when the provider max_requests_per_second argument is set.
+	limiter *rateLimiter
+}
+
+// defaultHTTPTimeout is the timeout of the requests of the API client, unless the provider
+// http_timeout argument is set.
+const defaultHTTPTimeout = 20 * time.Second
+
+func newAPIClient(host string, token string) *apiClient {
+	return &apiClient{
+		host:       strings.TrimSuffix(host, "/"),
+		token:      token,
+		authScheme: "Token",
+		httpClient: &http.Client{
+			Timeout: defaultHTTPTimeout,
+		},
+		maxRetries: defaultMaxRetries,
+		maxBackoff: defaultMaxBackoff,
+	}
+}
+
+// apiError is returned for every non-2xx response from the InfluxDB2 API.
+type apiError struct {
+	StatusCode int
+	// Code & Message come from the standard InfluxDB2 error body, when present.
+	Code    string
+	Message string
+	// RetryAfter is the parsed value of the Retry-After header, or zero.
+	RetryAfter time.Duration
+}
+
+func (e *apiError) Error() string {
+	switch {
+	case e.Code != "" && e.Message != "":
+		return fmt.Sprintf("%s: %s (HTTP %d)", e.Code, e.Message, e.StatusCode)
+	case e.Message != "":
+		return fmt.Sprintf("%s (HTTP %d)", e.Message, e.StatusCode)
+	default:
+		return fmt.Sprintf("unexpected status code %d", e.StatusCode)
+	}
+}
+
+// Errors are classified by HTTP status code and by the API error `code` field only. The
+// human readable message must never be matched on: it isn't part of the API contract, and
+// instances behind translating proxies return it localized.
+
+// isNotFound reports whether err is an API error for a missing object.
+func isNotFound(err error) bool {
+	return hasErrorCode(err, http.StatusNotFound, domain.ErrorCodeNotFound)
+}
+
+// isConflict reports whether err is an API error for an object that already exists.
+func isConflict(err error) bool {
+	return hasErrorCode(err, http.StatusConflict, domain.ErrorCodeConflict)
+}
+
+// isForbidden reports whether err is an API error for a request the token isn't allowed to
+// make, or for an API that the server configuration disabled.
+func isForbidden(err error) bool {
+	return hasErrorCode(err, http.StatusForbidden, domain.ErrorCodeForbidden)
+}
+
+// hasErrorCode reports whether err is an *apiError with the given status code or API error code.
+func hasErrorCode(err error, statusCode int, code domain.ErrorCode) bool {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == statusCode || apiErr.Code == string(code)
+}
+
+// newAPIError builds an apiError from an unsuccessful response. The body is consumed.
+func newAPIError(resp *http.Response) *apiError {
+	e := &apiError{StatusCode: resp.StatusCode}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+			e.RetryAfter = time.Duration(secs) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			e.RetryAfter = time.Until(t)
+		}
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	ctype, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if ctype == "application/json" {
+		var influxErr struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(body, &influxErr); err == nil {
+			e.Code = influxErr.Code
+			e.Message = influxErr.Message
+		}
+	}
+	if e.Message == "" {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	if e.Message == "" {
+		e.Message = resp.Header.Get("X-Influxdb-Error")
+	}
+
+	return e
+}
+
+// url returns the absolute URL for the given API path, e.g. "/api/v2/dbrps".
+func (c *apiClient) url(path string, query url.Values) string {
+	u := c.host + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// newRequest creates an authenticated request against the API path.
+func (c *apiClient) newRequest(ctx context.Context, method string, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path, query), body)
+	if err != nil {
+		return nil, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	// with session authentication, the session cookie is added by the cookie jar
+	if c.token != "" {
+		req.Header.Set("Authorization", c.authScheme+" "+c.token)
+	}
+	return req, nil
+}
+
+// send executes req and returns the response if it was successful. Any non-2xx
+// response is converted to an *apiError. Transient errors are retried with a backoff, see
+// isRetryable, and every attempt waits for the rate limiter, if any. The caller must close
+// the response body.
+func (c *apiClient) send(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %v%s", req.Method, req.URL.Path, err, logFieldsSuffix(req.Context()))
+			return nil, err
+		}
+		log.Printf("[DEBUG] %s %s: %d%s", req.Method, req.URL.Path, resp.StatusCode, logFieldsSuffix(req.Context()))
+		if resp.StatusCode == http.StatusUnauthorized && c.username != "" && req.URL.Path != signinPath {
+			if retried, err := c.retryWithNewSession(req); err == nil {
+				resp.Body.Close()
+				resp = retried
+			} else {
+				log.Printf("[WARN] unable to renew the session: %v", err)
+			}
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			return resp, nil
+		}
+
+		apiErr := newAPIError(resp)
+		resp.Body.Close()
+		if attempt > c.maxRetries || !isRetryable(req.Method, apiErr.StatusCode) {
+			return nil, apiErr
+		}
+		again, err := rewind(req)
+		if err != nil {
+			return nil, apiErr
+		}
+
+		wait := c.retryWait(apiErr, attempt)
+		log.Printf("[WARN] %s %s failed with status %d, retrying in %s (retry %d of %d)%s", req.Method, req.URL.Path, apiErr.StatusCode, wait, attempt, c.maxRetries, logFieldsSuffix(req.Context()))
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, apiErr
+		case <-timer.C:
+		}
+		req = again
+	}
+}
+
+// doJSON sends a request with an optional JSON encoded body (in) and decodes the
+// JSON response into out, if out is non-nil.
+func (c *apiClient) doJSON(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
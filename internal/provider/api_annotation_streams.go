@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// annotationStream is a named stream of annotations, e.g. deployment markers, in an
+// Organization.
+type annotationStream struct {
+	ID          string    `json:"id"`
+	OrgID       string    `json:"orgID"`
+	Name        string    `json:"stream"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type annotationStreamWrite struct {
+	Name        string `json:"stream"`
+	Description string `json:"description"`
+}
+
+// createAnnotationStream creates a stream. The API upserts streams by name, so an existing
+// stream with the same name is updated instead: check findAnnotationStreamByName first.
+func (c *apiClient) createAnnotationStream(ctx context.Context, orgID string, s *annotationStreamWrite) (*annotationStream, error) {
+	var created annotationStream
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v2/streams", url.Values{"orgID": []string{orgID}}, s, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// findAnnotationStreamByID looks the stream up in the streams of the Organization, since the
+// API can't get a single stream. A missing stream is reported as an *apiError with a 404
+// status code.
+func (c *apiClient) findAnnotationStreamByID(ctx context.Context, orgID string, id string) (*annotationStream, error) {
+	streams, err := c.findAnnotationStreams(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range streams {
+		if streams[i].ID == id {
+			return &streams[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "annotation stream \"" + id + "\" not found",
+	}
+}
+
+// findAnnotationStreamByName returns the stream with the given name, or nil when there is none.
+func (c *apiClient) findAnnotationStreamByName(ctx context.Context, orgID string, name string) (*annotationStream, error) {
+	streams, err := c.findAnnotationStreams(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range streams {
+		if streams[i].Name == name {
+			return &streams[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *apiClient) findAnnotationStreams(ctx context.Context, orgID string) ([]annotationStream, error) {
+	var streams []annotationStream
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/streams", url.Values{"orgID": []string{orgID}}, nil, &streams); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+func (c *apiClient) updateAnnotationStream(ctx context.Context, orgID string, id string, s *annotationStreamWrite) (*annotationStream, error) {
+	var updated annotationStream
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v2/streams/"+id, url.Values{"orgID": []string{orgID}}, s, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *apiClient) deleteAnnotationStream(ctx context.Context, orgID string, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/streams/"+id, url.Values{"orgID": []string{orgID}}, nil, nil)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// createAuthorization creates an API token. The token itself is only returned on creation
+// by InfluxDB Cloud.
+func (c *apiClient) createAuthorization(ctx context.Context, a *domain.Authorization) (*domain.Authorization, error) {
+	var created domain.Authorization
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/authorizations", nil, a, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findAuthorizationByID(ctx context.Context, id string) (*domain.Authorization, error) {
+	var a domain.Authorization
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/authorizations/"+id, nil, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (c *apiClient) updateAuthorization(ctx context.Context, id string, update *domain.AuthorizationUpdateRequest) (*domain.Authorization, error) {
+	var updated domain.Authorization
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/authorizations/"+id, nil, update, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *apiClient) deleteAuthorization(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/authorizations/"+id, nil, nil, nil)
+}
+
+// authorizationsFilter restricts the authorizations returned by findAuthorizations. Empty
+// fields don't filter.
+type authorizationsFilter struct {
+	userID   string
+	userName string
+	orgID    string
+	orgName  string
+}
+
+// findAuthorizations returns the authorizations the token can read. The tokens themselves
+// are redacted by InfluxDB Cloud, and must not be exposed anyway.
+func (c *apiClient) findAuthorizations(ctx context.Context, filter authorizationsFilter) ([]domain.Authorization, error) {
+	query := url.Values{}
+	for k, v := range map[string]string{
+		"userID": filter.userID,
+		"user":   filter.userName,
+		"orgID":  filter.orgID,
+		"org":    filter.orgName,
+	} {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+
+	var auths domain.Authorizations
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/authorizations", query, nil, &auths); err != nil {
+		return nil, err
+	}
+	if auths.Authorizations == nil {
+		return nil, nil
+	}
+	res := *auths.Authorizations
+	for i := range res {
+		res[i].Token = nil
+	}
+	return res, nil
+}
+
+// findResourceTypes returns the resource types that the permissions of a token can grant on
+// the server. Servers that don't list them report a 404.
+func (c *apiClient) findResourceTypes(ctx context.Context) ([]domain.ResourceType, error) {
+	var types []domain.ResourceType
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/resources", nil, nil, &types); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
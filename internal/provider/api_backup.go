@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The types below are the files of a backup as written by `influx backup`, so that backups
+// made by the provider can be restored by the CLI and the other way around.
+
+// backupManifest is the `<timestamp>.manifest` file describing the files of a backup.
+type backupManifest struct {
+	KV      backupFile          `json:"kv"`
+	SQL     *backupFile         `json:"sql,omitempty"`
+	Buckets []backupBucketEntry `json:"buckets"`
+}
+
+type backupFile struct {
+	FileName    string `json:"fileName"`
+	Size        int64  `json:"size"`
+	Compression string `json:"compression"`
+}
+
+type backupBucketEntry struct {
+	OrganizationID         string                  `json:"organizationID"`
+	OrganizationName       string                  `json:"organizationName"`
+	BucketID               string                  `json:"bucketID"`
+	BucketName             string                  `json:"bucketName"`
+	Description            *string                 `json:"description,omitempty"`
+	DefaultRetentionPolicy string                  `json:"defaultRetentionPolicy"`
+	RetentionPolicies      []backupRetentionPolicy `json:"retentionPolicies"`
+}
+
+type backupRetentionPolicy struct {
+	Name               string             `json:"name"`
+	ReplicaN           int                `json:"replicaN"`
+	Duration           int64              `json:"duration"`
+	ShardGroupDuration int64              `json:"shardGroupDuration"`
+	ShardGroups        []backupShardGroup `json:"shardGroups"`
+	Subscriptions      []json.RawMessage  `json:"subscriptions"`
+}
+
+type backupShardGroup struct {
+	ID          int64         `json:"id"`
+	StartTime   time.Time     `json:"startTime"`
+	EndTime     time.Time     `json:"endTime"`
+	DeletedAt   *time.Time    `json:"deletedAt,omitempty"`
+	TruncatedAt *time.Time    `json:"truncatedAt,omitempty"`
+	Shards      []backupShard `json:"shards"`
+}
+
+// backupShard is a shard of the metadata returned by the API, and of a manifest once its
+// data is backed up to a file.
+type backupShard struct {
+	ID          int64             `json:"id"`
+	ShardOwners []json.RawMessage `json:"shardOwners"`
+	// the file of the shard, only in a manifest
+	FileName    string `json:"fileName,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Compression string `json:"compression,omitempty"`
+}
+
+// streaming returns a copy of the client without the request timeout, for requests whose
+// bodies can be arbitrarily large. They are still bound by the context. The cookie jar is
+// shared, so that the copy keeps using the session of session authentication.
+func (c *apiClient) streaming() *apiClient {
+	s := *c
+	s.httpClient = &http.Client{
+		Transport:     c.httpClient.Transport,
+		CheckRedirect: c.httpClient.CheckRedirect,
+		Jar:           c.httpClient.Jar,
+	}
+	return &s
+}
+
+// backup writes a backup of the instance to dir, using the file layout of `influx backup`,
+// and returns the name of its manifest. When bucketID is set, only the data of that bucket
+// is backed up. Backups require an operator token.
+func (c *apiClient) backup(ctx context.Context, dir string, bucketID string) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	c = c.streaming()
+	prefix := time.Now().UTC().Format("20060102T150405Z")
+
+	var manifest backupManifest
+	if err := c.backupMetadata(ctx, dir, prefix, &manifest); err != nil {
+		return "", fmt.Errorf("unable to back up metadata: %v", err)
+	}
+
+	buckets := manifest.Buckets[:0]
+	for _, b := range manifest.Buckets {
+		if bucketID != "" && b.BucketID != bucketID {
+			continue
+		}
+		for i := range b.RetentionPolicies {
+			for j := range b.RetentionPolicies[i].ShardGroups {
+				g := &b.RetentionPolicies[i].ShardGroups[j]
+				shards := g.Shards[:0]
+				for _, s := range g.Shards {
+					f, err := c.backupShard(ctx, dir, prefix, s.ID)
+					if isNotFound(err) {
+						// the shard was deleted since the metadata was read
+						continue
+					}
+					if err != nil {
+						return "", fmt.Errorf("unable to back up shard %d of bucket (%s): %v", s.ID, b.BucketID, err)
+					}
+					s.FileName, s.Size, s.Compression = f.FileName, f.Size, f.Compression
+					shards = append(shards, s)
+				}
+				g.Shards = shards
+			}
+		}
+		buckets = append(buckets, b)
+	}
+	if bucketID != "" && len(buckets) == 0 {
+		return "", fmt.Errorf("bucket (%s) not found", bucketID)
+	}
+	manifest.Buckets = buckets
+
+	name := prefix + ".manifest"
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), b, 0o644); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// backupMetadata writes the KV & SQL stores of the instance, returned as parts of a
+// multipart response, and decodes the bucket metadata into the manifest.
+func (c *apiClient) backupMetadata(ctx context.Context, dir string, prefix string, manifest *backupManifest) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/api/v2/backup/metadata", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return err
+	}
+	parts := multipart.NewReader(resp.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch part.FormName() {
+		case "kv":
+			f, err := writeBackupFile(dir, prefix+".bolt.gz", part)
+			if err != nil {
+				return err
+			}
+			manifest.KV = *f
+		case "sql":
+			f, err := writeBackupFile(dir, prefix+".sqlite.gz", part)
+			if err != nil {
+				return err
+			}
+			manifest.SQL = f
+		case "buckets":
+			if err := json.NewDecoder(part).Decode(&manifest.Buckets); err != nil {
+				return err
+			}
+		}
+	}
+	if manifest.KV.FileName == "" {
+		return fmt.Errorf("no KV store in the response")
+	}
+	return nil
+}
+
+func (c *apiClient) backupShard(ctx context.Context, dir string, prefix string, shardID int64) (*backupFile, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v2/backup/shards/%d", shardID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return writeBackupFile(dir, fmt.Sprintf("%s.s%d.tar.gz", prefix, shardID), resp.Body)
+}
+
+// writeBackupFile writes r gzip compressed to a file of dir.
+func writeBackupFile(dir string, name string, r io.Reader) (*backupFile, error) {
+	f, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	if _, err := io.Copy(gz, r); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &backupFile{FileName: name, Size: info.Size(), Compression: "gzip"}, f.Close()
+}
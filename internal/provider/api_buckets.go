@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findBuckets returns all the buckets of an Organization, including the system buckets,
+// requesting them a page at a time.
+func (c *apiClient) findBuckets(ctx context.Context, orgID string) ([]domain.Bucket, error) {
+	var res []domain.Bucket
+	for offset := 0; ; offset += pageSize {
+		var buckets domain.Buckets
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/buckets", query, nil, &buckets); err != nil {
+			return nil, err
+		}
+		if buckets.Buckets == nil {
+			return res, nil
+		}
+		res = append(res, *buckets.Buckets...)
+		if len(*buckets.Buckets) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+func (c *apiClient) createBucket(ctx context.Context, b *domain.PostBucketRequest) (*domain.Bucket, error) {
+	var created domain.Bucket
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/buckets", nil, b, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findBucketByID(ctx context.Context, id string) (*domain.Bucket, error) {
+	var b domain.Bucket
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/buckets/"+id, nil, nil, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (c *apiClient) updateBucket(ctx context.Context, id string, b *domain.Bucket) (*domain.Bucket, error) {
+	var updated domain.Bucket
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/buckets/"+id, nil, b, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *apiClient) deleteBucket(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/buckets/"+id, nil, nil, nil)
+}
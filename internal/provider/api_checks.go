@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// check is a deadman or threshold check. The client library models checks as an untyped
+// interface, so only the attributes both kinds of check share are decoded.
+type check struct {
+	ID              string         `json:"id"`
+	OrgID           string         `json:"orgID"`
+	Name            string         `json:"name"`
+	Description     string         `json:"description"`
+	Type            string         `json:"type"`
+	Status          string         `json:"status"`
+	Query           checkQuery     `json:"query"`
+	Every           string         `json:"every"`
+	Offset          string         `json:"offset"`
+	Labels          []domain.Label `json:"labels"`
+	LastRunStatus   string         `json:"lastRunStatus"`
+	LastRunError    string         `json:"lastRunError"`
+	LatestCompleted *time.Time     `json:"latestCompleted"`
+}
+
+type checkQuery struct {
+	Text string `json:"text"`
+}
+
+type checks struct {
+	Checks []check `json:"checks"`
+}
+
+// labelNames returns the names of the labels of the check.
+func (c *check) labelNames() []string {
+	names := make([]string, 0, len(c.Labels))
+	for _, l := range c.Labels {
+		names = append(names, stringValue(l.Name))
+	}
+	return names
+}
+
+func (c *apiClient) findCheckByID(ctx context.Context, id string) (*check, error) {
+	var ch check
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/checks/"+id, nil, nil, &ch); err != nil {
+		return nil, err
+	}
+	return &ch, nil
+}
+
+// findChecks returns all the checks of an Organization, requesting them a page at a time.
+func (c *apiClient) findChecks(ctx context.Context, orgID string) ([]check, error) {
+	var res []check
+	for offset := 0; ; offset += pageSize {
+		var page checks
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/checks", query, nil, &page); err != nil {
+			return nil, err
+		}
+		res = append(res, page.Checks...)
+		if len(page.Checks) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+// findCheckByName returns the check of an Organization with the given name. The API can't
+// filter checks by name, so a missing check is reported as an *apiError with a 404 status
+// code.
+func (c *apiClient) findCheckByName(ctx context.Context, orgID string, name string) (*check, error) {
+	all, err := c.findChecks(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].Name == name {
+			return &all[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "check name \"" + name + "\" not found",
+	}
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// serverConfig returns the runtime configuration of an InfluxDB2 OSS server, keyed by the
+// name of each setting, e.g. `query-concurrency`. It needs an operator token.
+func (c *apiClient) serverConfig(ctx context.Context) (map[string]json.RawMessage, error) {
+	var res struct {
+		Config map[string]json.RawMessage `json:"config"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/config", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Config, nil
+}
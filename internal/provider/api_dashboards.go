@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findDashboardByID returns a Dashboard with the properties of the views of its cells.
+func (c *apiClient) findDashboardByID(ctx context.Context, id string) (*domain.DashboardWithViewProperties, error) {
+	var dashboard domain.DashboardWithViewProperties
+	query := url.Values{"include": []string{"properties"}}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/dashboards/"+id, query, nil, &dashboard); err != nil {
+		return nil, err
+	}
+	return &dashboard, nil
+}
+
+// findDashboards returns all the Dashboards of an Organization, without the properties of
+// their views, requesting them a page at a time.
+func (c *apiClient) findDashboards(ctx context.Context, orgID string) ([]domain.Dashboard, error) {
+	var res []domain.Dashboard
+	for offset := 0; ; offset += pageSize {
+		var dashboards domain.Dashboards
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/dashboards", query, nil, &dashboards); err != nil {
+			return nil, err
+		}
+		if dashboards.Dashboards == nil {
+			return res, nil
+		}
+		res = append(res, *dashboards.Dashboards...)
+		if len(*dashboards.Dashboards) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+// findDashboardByName returns the Dashboard of an Organization with the given name. The
+// API can't filter Dashboards by name, so a missing Dashboard is reported as an *apiError
+// with a 404 status code.
+func (c *apiClient) findDashboardByName(ctx context.Context, orgID string, name string) (*domain.DashboardWithViewProperties, error) {
+	all, err := c.findDashboards(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, dashboard := range all {
+		if dashboard.Name == name && dashboard.Id != nil {
+			return c.findDashboardByID(ctx, *dashboard.Id)
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "dashboard name \"" + name + "\" not found",
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// dedicatedClient talks to the Management API of an InfluxDB Cloud Dedicated cluster, which
+// manages the databases that Dedicated has in place of buckets.
+type dedicatedClient struct {
+	api       *apiClient
+	accountID string
+	clusterID string
+}
+
+func newDedicatedClient(managementURL string, managementToken string, accountID string, clusterID string) *dedicatedClient {
+	api := newAPIClient(managementURL, managementToken)
+	api.authScheme = "Bearer"
+	return &dedicatedClient{
+		api:       api,
+		accountID: accountID,
+		clusterID: clusterID,
+	}
+}
+
+// dedicatedAPI returns the Management API client, or an error when the cloud_dedicated
+// provider block isn't set.
+func dedicatedAPI(meta interface{}) (*dedicatedClient, error) {
+	if c := meta.(*metaData).dedicated; c != nil {
+		return c, nil
+	}
+	return nil, fmt.Errorf("the cloud_dedicated provider block must be set to manage InfluxDB Cloud Dedicated databases")
+}
+
+func (c *dedicatedClient) clusterPath() string {
+	return "/api/v0/accounts/" + c.accountID + "/clusters/" + c.clusterID
+}
+
+// database is a Cloud Dedicated database. RetentionPeriod is in nanoseconds, 0 for infinite.
+type database struct {
+	AccountID          string              `json:"accountId,omitempty"`
+	ClusterID          string              `json:"clusterId,omitempty"`
+	Name               string              `json:"name"`
+	MaxTables          int                 `json:"maxTables,omitempty"`
+	MaxColumnsPerTable int                 `json:"maxColumnsPerTable,omitempty"`
+	RetentionPeriod    int64               `json:"retentionPeriod"`
+	PartitionTemplate  []databasePartition `json:"partitionTemplate,omitempty"`
+}
+
+// databasePartition is a part of the partition template of a database. Value is a tag name
+// for `tag` parts, a time format for `time` parts, and a databaseBucketPartition for
+// `bucket` parts.
+type databasePartition struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type databaseBucketPartition struct {
+	TagName         string `json:"tagName"`
+	NumberOfBuckets int    `json:"numberOfBuckets"`
+}
+
+type databaseUpdate struct {
+	MaxTables          int   `json:"maxTables,omitempty"`
+	MaxColumnsPerTable int   `json:"maxColumnsPerTable,omitempty"`
+	RetentionPeriod    int64 `json:"retentionPeriod"`
+}
+
+func (c *dedicatedClient) createDatabase(ctx context.Context, db *database) (*database, error) {
+	var created database
+	if err := c.api.doJSON(ctx, http.MethodPost, c.clusterPath()+"/databases", nil, db, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *dedicatedClient) findDatabases(ctx context.Context) ([]database, error) {
+	var databases []database
+	if err := c.api.doJSON(ctx, http.MethodGet, c.clusterPath()+"/databases", nil, nil, &databases); err != nil {
+		return nil, err
+	}
+	return databases, nil
+}
+
+// findDatabaseByName returns a database of the cluster. The API can't get a single database,
+// so a missing one is reported as an *apiError with a 404 status code.
+func (c *dedicatedClient) findDatabaseByName(ctx context.Context, name string) (*database, error) {
+	databases, err := c.findDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range databases {
+		if databases[i].Name == name {
+			return &databases[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "database \"" + name + "\" not found",
+	}
+}
+
+func (c *dedicatedClient) updateDatabase(ctx context.Context, name string, update *databaseUpdate) (*database, error) {
+	var updated database
+	if err := c.api.doJSON(ctx, http.MethodPatch, c.clusterPath()+"/databases/"+name, nil, update, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *dedicatedClient) deleteDatabase(ctx context.Context, name string) error {
+	return c.api.doJSON(ctx, http.MethodDelete, c.clusterPath()+"/databases/"+name, nil, nil, nil)
+}
+
+// databaseToken is a token of a Cloud Dedicated cluster. AccessToken is only returned on
+// creation.
+type databaseToken struct {
+	ID          string                    `json:"id,omitempty"`
+	AccountID   string                    `json:"accountId,omitempty"`
+	ClusterID   string                    `json:"clusterId,omitempty"`
+	Description string                    `json:"description"`
+	Permissions []databaseTokenPermission `json:"permissions"`
+	CreatedAt   string                    `json:"createdAt,omitempty"`
+	AccessToken string                    `json:"accessToken,omitempty"`
+}
+
+// databaseTokenPermission grants an action, `read` or `write`, on a database by name.
+type databaseTokenPermission struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+}
+
+type databaseTokenUpdate struct {
+	Description string                    `json:"description"`
+	Permissions []databaseTokenPermission `json:"permissions"`
+}
+
+func (c *dedicatedClient) createDatabaseToken(ctx context.Context, t *databaseToken) (*databaseToken, error) {
+	var created databaseToken
+	if err := c.api.doJSON(ctx, http.MethodPost, c.clusterPath()+"/tokens", nil, t, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *dedicatedClient) findDatabaseTokenByID(ctx context.Context, id string) (*databaseToken, error) {
+	var t databaseToken
+	if err := c.api.doJSON(ctx, http.MethodGet, c.clusterPath()+"/tokens/"+id, nil, nil, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (c *dedicatedClient) updateDatabaseToken(ctx context.Context, id string, update *databaseTokenUpdate) (*databaseToken, error) {
+	var updated databaseToken
+	if err := c.api.doJSON(ctx, http.MethodPatch, c.clusterPath()+"/tokens/"+id, nil, update, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *dedicatedClient) deleteDatabaseToken(ctx context.Context, id string) error {
+	return c.api.doJSON(ctx, http.MethodDelete, c.clusterPath()+"/tokens/"+id, nil, nil, nil)
+}
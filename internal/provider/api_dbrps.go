@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// dbrp is a DBRP mapping, as returned by /api/v2/dbrps. The generated domain.DBRP model
+// doesn't match what InfluxDB actually returns (e.g. list responses), so the provider
+// decodes into its own type.
+type dbrp struct {
+	ID              string `json:"id,omitempty"`
+	OrgID           string `json:"orgID"`
+	BucketID        string `json:"bucketID"`
+	Database        string `json:"database"`
+	RetentionPolicy string `json:"retention_policy"`
+	Default         bool   `json:"default"`
+	// Virtual mappings are generated by InfluxDB for every bucket and can't be modified.
+	Virtual bool `json:"virtual,omitempty"`
+}
+
+// dbrpResponse decodes a single mapping. Depending on the InfluxDB version the mapping is
+// either returned directly, or wrapped in a "content" object.
+type dbrpResponse struct {
+	dbrp
+	Content *dbrp `json:"content"`
+}
+
+func (r *dbrpResponse) mapping() *dbrp {
+	if r.Content != nil {
+		return r.Content
+	}
+	return &r.dbrp
+}
+
+type dbrpsResponse struct {
+	Content []dbrp `json:"content"`
+}
+
+type dbrpUpdate struct {
+	RetentionPolicy *string `json:"retention_policy,omitempty"`
+	Default         *bool   `json:"default,omitempty"`
+}
+
+func (c *apiClient) createDBRP(ctx context.Context, mapping *dbrp) (*dbrp, error) {
+	var resp dbrpResponse
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/dbrps", nil, mapping, &resp); err != nil {
+		return nil, err
+	}
+	return resp.mapping(), nil
+}
+
+func (c *apiClient) findDBRPByID(ctx context.Context, orgID string, id string) (*dbrp, error) {
+	var resp dbrpResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/dbrps/"+id, url.Values{"orgID": []string{orgID}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.mapping(), nil
+}
+
+// findDBRPs lists the mappings in an Organization, filtered by the non-empty query values
+// (bucketID, db, rp, default, id).
+func (c *apiClient) findDBRPs(ctx context.Context, orgID string, filter url.Values) ([]dbrp, error) {
+	query := url.Values{"orgID": []string{orgID}}
+	for k, v := range filter {
+		query[k] = v
+	}
+	var resp dbrpsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/dbrps", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+func (c *apiClient) updateDBRP(ctx context.Context, orgID string, id string, update *dbrpUpdate) (*dbrp, error) {
+	var resp dbrpResponse
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/dbrps/"+id, url.Values{"orgID": []string{orgID}}, update, &resp); err != nil {
+		return nil, err
+	}
+	return resp.mapping(), nil
+}
+
+func (c *apiClient) deleteDBRP(ctx context.Context, orgID string, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/dbrps/"+id, url.Values{"orgID": []string{orgID}}, nil, nil)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type deleteRequest struct {
+	Start     time.Time `json:"start"`
+	Stop      time.Time `json:"stop"`
+	Predicate string    `json:"predicate"`
+}
+
+// deletePoints deletes the points of a bucket matching a predicate in a time range.
+func (c *apiClient) deletePoints(ctx context.Context, orgID string, bucketID string, req *deleteRequest) error {
+	query := url.Values{
+		"orgID":    []string{orgID},
+		"bucketID": []string{bucketID},
+	}
+	return c.doJSON(ctx, http.MethodPost, "/api/v2/delete", query, req, nil)
+}
@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// flags returns the feature flags of the server, keyed by name. Their values can be of
+// any type.
+func (c *apiClient) flags(ctx context.Context) (map[string]json.RawMessage, error) {
+	var res map[string]json.RawMessage
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/flags", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// health returns the health of the server. A failing server answers with a 503 status code
+// but still describes its health, so the response is decoded for both status codes.
+func (c *apiClient) health(ctx context.Context) (*domain.HealthCheck, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/health", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
+		return nil, newAPIError(resp)
+	}
+
+	var check domain.HealthCheck
+	if err := json.NewDecoder(resp.Body).Decode(&check); err != nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil, err
+	}
+	return &check, nil
+}
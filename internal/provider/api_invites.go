@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// invite is a pending invitation for an email address to join an InfluxDB Cloud
+// Organization. Once accepted, the invite is removed and the user becomes a member or owner.
+type invite struct {
+	ID        string     `json:"id,omitempty"`
+	Email     string     `json:"email"`
+	Role      string     `json:"role"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type invitesResponse struct {
+	Invites []invite `json:"invites"`
+}
+
+// The invites API is only available on InfluxDB Cloud, under its private API prefix.
+func invitesPath(orgID string) string {
+	return "/api/v2private/orgs/" + orgID + "/invites"
+}
+
+func (c *apiClient) createInvite(ctx context.Context, orgID string, inv *invite) (*invite, error) {
+	var created invite
+	if err := c.doJSON(ctx, http.MethodPost, invitesPath(orgID), nil, inv, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findInvites(ctx context.Context, orgID string) ([]invite, error) {
+	var resp invitesResponse
+	if err := c.doJSON(ctx, http.MethodGet, invitesPath(orgID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Invites, nil
+}
+
+// findInviteByID returns a pending invite. An invite that was accepted, withdrawn or has
+// been cleaned up after expiring is reported as an *apiError with a 404 status code.
+func (c *apiClient) findInviteByID(ctx context.Context, orgID string, id string) (*invite, error) {
+	invites, err := c.findInvites(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range invites {
+		if invites[i].ID == id {
+			return &invites[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "invite \"" + id + "\" not found",
+	}
+}
+
+func (c *apiClient) deleteInvite(ctx context.Context, orgID string, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, invitesPath(orgID)+"/"+id, nil, nil, nil)
+}
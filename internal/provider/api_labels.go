@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findLabels returns all the labels of an Organization.
+func (c *apiClient) findLabels(ctx context.Context, orgID string) ([]domain.Label, error) {
+	var labels domain.LabelsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/labels", url.Values{"orgID": []string{orgID}}, nil, &labels); err != nil {
+		return nil, err
+	}
+	if labels.Labels == nil {
+		return nil, nil
+	}
+	return *labels.Labels, nil
+}
+
+func (c *apiClient) findLabelByID(ctx context.Context, id string) (*domain.Label, error) {
+	var label domain.LabelResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/labels/"+id, nil, nil, &label); err != nil {
+		return nil, err
+	}
+	if label.Label == nil {
+		return nil, &apiError{
+			StatusCode: http.StatusNotFound,
+			Code:       string(domain.ErrorCodeNotFound),
+			Message:    "label \"" + id + "\" not found",
+		}
+	}
+	return label.Label, nil
+}
+
+// findLabelByName returns the label of an Organization with the given name. The API can't
+// filter labels by name, so a missing label is reported as an *apiError with a 404 status
+// code.
+func (c *apiClient) findLabelByName(ctx context.Context, orgID string, name string) (*domain.Label, error) {
+	labels, err := c.findLabels(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range labels {
+		if stringValue(labels[i].Name) == name {
+			return &labels[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "label name \"" + name + "\" not found",
+	}
+}
+
+// labelProperty returns a property of a label, e.g. its `color`, or "".
+func labelProperty(l domain.Label, name string) string {
+	if l.Properties == nil {
+		return ""
+	}
+	v, _ := l.Properties.Get(name)
+	return v
+}
+
+// hasAllLabels returns whether the labels of a resource include all the labels with the
+// given names.
+func hasAllLabels(labels []domain.Label, names []string) bool {
+	has := map[string]bool{}
+	for _, l := range labels {
+		has[stringValue(l.Name)] = true
+	}
+	for _, name := range names {
+		if !has[name] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// measurementSchema is the explicit schema of a measurement in a bucket with an explicit
+// schema type, an InfluxDB Cloud feature. Columns can only be added, and schemas can't be
+// deleted.
+type measurementSchema struct {
+	ID       string                    `json:"id,omitempty"`
+	OrgID    string                    `json:"orgID,omitempty"`
+	BucketID string                    `json:"bucketID,omitempty"`
+	Name     string                    `json:"name"`
+	Columns  []measurementSchemaColumn `json:"columns"`
+}
+
+type measurementSchemaColumn struct {
+	Name string `json:"name"`
+	// Type is `tag`, `field` or `timestamp`
+	Type string `json:"type"`
+	// DataType is only set for fields: `integer`, `float`, `boolean`, `string` or `unsigned`
+	DataType string `json:"dataType,omitempty"`
+}
+
+type measurementSchemaUpdate struct {
+	Columns []measurementSchemaColumn `json:"columns"`
+}
+
+func measurementSchemasPath(bucketID string) string {
+	return "/api/v2/buckets/" + bucketID + "/schema/measurements"
+}
+
+func (c *apiClient) createMeasurementSchema(ctx context.Context, bucketID string, s *measurementSchema) (*measurementSchema, error) {
+	var created measurementSchema
+	if err := c.doJSON(ctx, http.MethodPost, measurementSchemasPath(bucketID), nil, s, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findMeasurementSchemaByID(ctx context.Context, bucketID string, id string) (*measurementSchema, error) {
+	var s measurementSchema
+	if err := c.doJSON(ctx, http.MethodGet, measurementSchemasPath(bucketID)+"/"+id, nil, nil, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+type measurementSchemas struct {
+	MeasurementSchemas []measurementSchema `json:"measurementSchemas"`
+}
+
+// findMeasurementSchemas returns the schemas of all the measurements of a bucket. The API
+// doesn't page them.
+func (c *apiClient) findMeasurementSchemas(ctx context.Context, orgID string, bucketID string) ([]measurementSchema, error) {
+	var res measurementSchemas
+	if err := c.doJSON(ctx, http.MethodGet, measurementSchemasPath(bucketID), url.Values{"orgID": []string{orgID}}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.MeasurementSchemas, nil
+}
+
+// updateMeasurementSchema replaces the columns of a schema, which must include all the
+// existing columns unchanged.
+func (c *apiClient) updateMeasurementSchema(ctx context.Context, bucketID string, id string, columns []measurementSchemaColumn) (*measurementSchema, error) {
+	var s measurementSchema
+	if err := c.doJSON(ctx, http.MethodPatch, measurementSchemasPath(bucketID)+"/"+id, nil, measurementSchemaUpdate{Columns: columns}, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// queryMonitoring returns the latest records of a measurement of the `_monitoring` system
+// bucket, `statuses` or `notifications`, whose tag equals value, newest first. The fields of
+// each record are pivoted into columns, next to its tags.
+func (c *apiClient) queryMonitoring(ctx context.Context, orgID string, measurement string, tag string, value string, start string, limit int) ([]map[string]string, error) {
+	raw, err := c.query(ctx, orgID, fmt.Sprintf(`from(bucket: "_monitoring")
+  |> range(start: %s)
+  |> filter(fn: (r) => r._measurement == %s and r[%s] == %s)
+  |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+  |> group()
+  |> sort(columns: ["_time"], desc: true)
+  |> limit(n: %d)`, start, fluxString(measurement), fluxString(tag), fluxString(value), limit))
+	if err != nil {
+		return nil, err
+	}
+	return parseAnnotatedCSV(raw)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// notificationEndpoint is a check notification endpoint. Credentials, such as the token of
+// a Slack endpoint or the password of an HTTP endpoint, are stored as secrets and never
+// returned by the API.
+type notificationEndpoint struct {
+	ID              string            `json:"id"`
+	OrgID           string            `json:"orgID"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Status          string            `json:"status"`
+	Type            string            `json:"type"`
+	URL             string            `json:"url"`
+	ClientURL       string            `json:"clientURL"`
+	Method          string            `json:"method"`
+	AuthMethod      string            `json:"authMethod"`
+	Headers         map[string]string `json:"headers"`
+	ContentTemplate string            `json:"contentTemplate"`
+}
+
+func (c *apiClient) findNotificationEndpointByID(ctx context.Context, id string) (*notificationEndpoint, error) {
+	var e notificationEndpoint
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/notificationEndpoints/"+id, nil, nil, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+type notificationEndpoints struct {
+	NotificationEndpoints []notificationEndpoint `json:"notificationEndpoints"`
+}
+
+// findNotificationEndpoints returns all the notification endpoints of an Organization,
+// requesting them a page at a time.
+func (c *apiClient) findNotificationEndpoints(ctx context.Context, orgID string) ([]notificationEndpoint, error) {
+	var res []notificationEndpoint
+	for offset := 0; ; offset += pageSize {
+		var page notificationEndpoints
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/notificationEndpoints", query, nil, &page); err != nil {
+			return nil, err
+		}
+		res = append(res, page.NotificationEndpoints...)
+		if len(page.NotificationEndpoints) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+// findNotificationEndpointByName returns the notification endpoint of an Organization with
+// the given name. The API can't filter endpoints by name, so a missing endpoint is reported
+// as an *apiError with a 404 status code.
+func (c *apiClient) findNotificationEndpointByName(ctx context.Context, orgID string, name string) (*notificationEndpoint, error) {
+	all, err := c.findNotificationEndpoints(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].Name == name {
+			return &all[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "notification endpoint name \"" + name + "\" not found",
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// notificationRule is a rule sending the statuses written by checks to a notification
+// endpoint. Only the attributes all types of rules share are decoded.
+type notificationRule struct {
+	ID              string                       `json:"id"`
+	OrgID           string                       `json:"orgID"`
+	Name            string                       `json:"name"`
+	Description     string                       `json:"description"`
+	Type            string                       `json:"type"`
+	Status          string                       `json:"status"`
+	EndpointID      string                       `json:"endpointID"`
+	Every           string                       `json:"every"`
+	Offset          string                       `json:"offset"`
+	TagRules        []notificationRuleTagRule    `json:"tagRules"`
+	StatusRules     []notificationRuleStatusRule `json:"statusRules"`
+	LastRunStatus   string                       `json:"lastRunStatus"`
+	LatestCompleted *time.Time                   `json:"latestCompleted"`
+}
+
+type notificationRuleTagRule struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Operator string `json:"operator"`
+}
+
+type notificationRuleStatusRule struct {
+	CurrentLevel  string `json:"currentLevel"`
+	PreviousLevel string `json:"previousLevel"`
+}
+
+type notificationRules struct {
+	NotificationRules []notificationRule `json:"notificationRules"`
+}
+
+// notificationRulesFilter restricts the rules returned by findNotificationRules. Empty
+// fields don't filter.
+type notificationRulesFilter struct {
+	orgID string
+	// checkID only returns the rules matching the tags of the check
+	checkID string
+	// tags only returns the rules matching all the tags
+	tags map[string]string
+}
+
+// findNotificationRules returns the notification rules of an Organization, requesting them
+// a page at a time.
+func (c *apiClient) findNotificationRules(ctx context.Context, filter notificationRulesFilter) ([]notificationRule, error) {
+	query := url.Values{
+		"orgID": []string{filter.orgID},
+		"limit": []string{strconv.Itoa(pageSize)},
+	}
+	if filter.checkID != "" {
+		query.Set("checkID", filter.checkID)
+	}
+	for k, v := range filter.tags {
+		query.Add("tag", k+":"+v)
+	}
+
+	var res []notificationRule
+	for offset := 0; ; offset += pageSize {
+		var page notificationRules
+		query.Set("offset", strconv.Itoa(offset))
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/notificationRules", query, nil, &page); err != nil {
+			return nil, err
+		}
+		res = append(res, page.NotificationRules...)
+		if len(page.NotificationRules) < pageSize {
+			return res, nil
+		}
+	}
+}
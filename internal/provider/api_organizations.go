@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findOrganizationByID returns the Organization with the given ID. A missing Organization
+// is reported as an *apiError with a 404 status code.
+func (c *apiClient) findOrganizationByID(ctx context.Context, id string) (*domain.Organization, error) {
+	var org domain.Organization
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/orgs/"+id, nil, nil, &org); err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// findOrganizationByName returns the Organization with the given name. When no Organization
+// matches, an *apiError with a 404 status code is returned, the same as for a lookup by ID.
+func (c *apiClient) findOrganizationByName(ctx context.Context, name string) (*domain.Organization, error) {
+	var orgs domain.Organizations
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/orgs", url.Values{"org": []string{name}}, nil, &orgs); err != nil {
+		return nil, err
+	}
+	if orgs.Orgs == nil || len(*orgs.Orgs) == 0 {
+		return nil, &apiError{
+			StatusCode: http.StatusNotFound,
+			Code:       string(domain.ErrorCodeNotFound),
+			Message:    "organization name \"" + name + "\" not found",
+		}
+	}
+	return &(*orgs.Orgs)[0], nil
+}
+
+// findOrganizations returns all the Organizations the token can read, requesting them a page
+// at a time.
+func (c *apiClient) findOrganizations(ctx context.Context) ([]domain.Organization, error) {
+	var res []domain.Organization
+	for offset := 0; ; offset += pageSize {
+		var orgs domain.Organizations
+		query := url.Values{
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/orgs", query, nil, &orgs); err != nil {
+			return nil, err
+		}
+		if orgs.Orgs == nil {
+			return res, nil
+		}
+		res = append(res, *orgs.Orgs...)
+		if len(*orgs.Orgs) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+func (c *apiClient) createOrganization(ctx context.Context, org *domain.Organization) (*domain.Organization, error) {
+	var created domain.Organization
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/orgs", nil, org, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) updateOrganization(ctx context.Context, id string, org *domain.Organization) (*domain.Organization, error) {
+	var updated domain.Organization
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/orgs/"+id, nil, org, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *apiClient) deleteOrganization(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/orgs/"+id, nil, nil, nil)
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type queryRequest struct {
+	Query   string       `json:"query"`
+	Type    string       `json:"type"`
+	Dialect queryDialect `json:"dialect"`
+}
+
+type queryDialect struct {
+	Header      bool     `json:"header"`
+	Annotations []string `json:"annotations"`
+}
+
+// maxQueryResponseSize caps the annotated CSV of a query, which is read into memory and then
+// into the state, so that an unbounded query fails rather than exhausting either.
+var maxQueryResponseSize int64 = 32 << 20
+
+// query runs a Flux query in an Organization and returns the raw annotated CSV response.
+func (c *apiClient) query(ctx context.Context, orgID string, flux string) (string, error) {
+	b, err := json.Marshal(queryRequest{
+		Query: flux,
+		Type:  "flux",
+		Dialect: queryDialect{
+			Header:      true,
+			Annotations: []string{"datatype", "group", "default"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/api/v2/query", url.Values{"orgID": []string{orgID}}, bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/csv")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	raw, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxQueryResponseSize+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(raw)) > maxQueryResponseSize {
+		return "", fmt.Errorf("the query response exceeds %d bytes, limit the query to fewer rows", maxQueryResponseSize)
+	}
+	return string(raw), nil
+}
+
+// parseAnnotatedCSV converts an annotated CSV query response into one map per row, keyed
+// by column name. Values are kept as the strings returned by InfluxDB.
+func parseAnnotatedCSV(raw string) ([]map[string]string, error) {
+	rows := []map[string]string{}
+	err := readAnnotatedCSV(raw, func(row map[string]string, _ map[string]string) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// readAnnotatedCSV calls fn for each row of an annotated CSV query response, with the row
+// and the `#datatype` annotation of its table, both keyed by column name. Each table of the
+// response starts with annotation rows and a header row. The leading annotation column is
+// dropped.
+func readAnnotatedCSV(raw string, fn func(row map[string]string, datatypes map[string]string) error) error {
+	r := csv.NewReader(strings.NewReader(raw))
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = false
+
+	var header, datatypeRecord []string
+	var datatypes map[string]string // of the current table
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// tables are separated by blank lines, which the reader skips, and always
+		// start with annotations
+		if strings.HasPrefix(record[0], "#") {
+			header = nil
+			if record[0] == "#datatype" {
+				datatypeRecord = record
+			}
+			continue
+		}
+		if header == nil {
+			header = record
+			datatypes = make(map[string]string, len(header))
+			for i, name := range header {
+				if i > 0 && i < len(datatypeRecord) {
+					datatypes[name] = datatypeRecord[i]
+				}
+			}
+			continue
+		}
+		if len(record) != len(header) {
+			return fmt.Errorf("row has %d columns, expected %d", len(record), len(header))
+		}
+
+		row := make(map[string]string, len(record))
+		for i, v := range record {
+			if i == 0 && header[i] == "" {
+				continue
+			}
+			row[header[i]] = v
+		}
+
+		// errors that happen once the response has started are sent as a table with an
+		// `error` column, with a 200 status code
+		if msg, ok := row["error"]; ok && len(row) <= 2 {
+			return fmt.Errorf("query failed: %s", msg)
+		}
+
+		if err := fn(row, datatypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
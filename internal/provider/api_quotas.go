@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// orgLimits are the quotas of an InfluxDB Cloud Organization. A maximum <= 0 is unlimited.
+type orgLimits struct {
+	OrgID  string `json:"orgID"`
+	Bucket struct {
+		MaxBuckets int `json:"maxBuckets"`
+		// MaxRetentionDuration is in nanoseconds.
+		MaxRetentionDuration int64 `json:"maxRetentionDuration"`
+	} `json:"bucket"`
+	Task struct {
+		MaxTasks int `json:"maxTasks"`
+	} `json:"task"`
+	Dashboard struct {
+		MaxDashboards int `json:"maxDashboards"`
+	} `json:"dashboard"`
+	Check struct {
+		MaxChecks int `json:"maxChecks"`
+	} `json:"check"`
+	NotificationRule struct {
+		MaxNotifications int `json:"maxNotifications"`
+	} `json:"notificationRule"`
+	Rate struct {
+		ReadKBs   int `json:"readKBs"`
+		WriteKBs  int `json:"writeKBs"`
+		QueryTime int `json:"queryTime"`
+		// Cardinality is the maximum series cardinality of the Organization.
+		Cardinality int `json:"cardinality"`
+	} `json:"rate"`
+}
+
+// orgLimitsResponse handles both the wrapped `limits` shape and the direct one.
+type orgLimitsResponse struct {
+	orgLimits
+}
+
+func (r *orgLimitsResponse) UnmarshalJSON(b []byte) error {
+	type wrapped struct {
+		Limits *orgLimits `json:"limits"`
+	}
+	var w wrapped
+	if err := json.Unmarshal(b, &w); err != nil {
+		return err
+	}
+	if w.Limits != nil {
+		r.orgLimits = *w.Limits
+		return nil
+	}
+	return json.Unmarshal(b, &r.orgLimits)
+}
+
+// findOrgLimits returns the quotas of an Organization. Only InfluxDB Cloud has quotas; OSS
+// answers with a 404.
+func (c *apiClient) findOrgLimits(ctx context.Context, orgID string) (*orgLimits, error) {
+	var resp orgLimitsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/orgs/"+orgID+"/limits", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.orgLimits, nil
+}
+
+const pageSize = 100
+
+// countBuckets counts the user buckets of an Organization; system buckets don't count
+// towards the quota.
+func (c *apiClient) countBuckets(ctx context.Context, orgID string) (int, error) {
+	count := 0
+	for offset := 0; ; offset += pageSize {
+		var resp struct {
+			Buckets []struct {
+				Type string `json:"type"`
+			} `json:"buckets"`
+		}
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/buckets", query, nil, &resp); err != nil {
+			return 0, err
+		}
+		for _, b := range resp.Buckets {
+			if b.Type != "system" {
+				count++
+			}
+		}
+		if len(resp.Buckets) < pageSize {
+			return count, nil
+		}
+	}
+}
+
+func (c *apiClient) countDashboards(ctx context.Context, orgID string) (int, error) {
+	count := 0
+	for offset := 0; ; offset += pageSize {
+		var resp struct {
+			Dashboards []struct{} `json:"dashboards"`
+		}
+		query := url.Values{
+			"orgID":  []string{orgID},
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/dashboards", query, nil, &resp); err != nil {
+			return 0, err
+		}
+		count += len(resp.Dashboards)
+		if len(resp.Dashboards) < pageSize {
+			return count, nil
+		}
+	}
+}
+
+// countTasks counts the tasks of an Organization. The tasks API pages with `after` instead
+// of `offset`.
+func (c *apiClient) countTasks(ctx context.Context, orgID string) (int, error) {
+	count := 0
+	after := ""
+	for {
+		var resp struct {
+			Tasks []struct {
+				ID string `json:"id"`
+			} `json:"tasks"`
+		}
+		query := url.Values{
+			"orgID": []string{orgID},
+			"limit": []string{strconv.Itoa(pageSize)},
+		}
+		if after != "" {
+			query.Set("after", after)
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/tasks", query, nil, &resp); err != nil {
+			return 0, err
+		}
+		count += len(resp.Tasks)
+		if len(resp.Tasks) < pageSize {
+			return count, nil
+		}
+		after = resp.Tasks[len(resp.Tasks)-1].ID
+	}
+}
+
+// bucketCardinality returns the series cardinality of a bucket over the last 30 days.
+func (c *apiClient) bucketCardinality(ctx context.Context, orgID string, bucketID string) (int, error) {
+	raw, err := c.query(ctx, orgID, fmt.Sprintf(`import "influxdata/influxdb"
+
+influxdb.cardinality(bucketID: %q, start: -30d)`, bucketID))
+	if err != nil {
+		return 0, err
+	}
+	rows, err := parseAnnotatedCSV(raw)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, row := range rows {
+		n, err := strconv.Atoi(row["_value"])
+		if err != nil {
+			return 0, fmt.Errorf("unexpected cardinality %q: %v", row["_value"], err)
+		}
+		count += n
+	}
+	return count, nil
+}
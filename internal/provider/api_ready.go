@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// serverReady is the readiness of the server, with the version & build it reports in the
+// headers of every response.
+type serverReady struct {
+	domain.Ready
+	// Version is e.g. `2.0.4`, or `v2.0.4` for some builds
+	Version string
+	// Build is `OSS` or `Cloud`
+	Build string
+}
+
+func (c *apiClient) ready(ctx context.Context) (*serverReady, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/ready", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	res := &serverReady{
+		Version: resp.Header.Get("X-Influxdb-Version"),
+		Build:   resp.Header.Get("X-Influxdb-Build"),
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res.Ready); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
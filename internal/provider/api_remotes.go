@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// remote is a connection to a remote InfluxDB2 instance, used as the target of replications.
+// The API never returns the remote token.
+type remote struct {
+	ID               string `json:"id,omitempty"`
+	OrgID            string `json:"orgID"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	RemoteURL        string `json:"remoteURL"`
+	RemoteOrgID      string `json:"remoteOrgID"`
+	RemoteAPIToken   string `json:"remoteAPIToken,omitempty"`
+	AllowInsecureTLS bool   `json:"allowInsecureTLS"`
+}
+
+// remoteUpdate only sends the remote token when it changed.
+type remoteUpdate struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	RemoteURL        string  `json:"remoteURL"`
+	RemoteOrgID      string  `json:"remoteOrgID"`
+	RemoteAPIToken   *string `json:"remoteAPIToken,omitempty"`
+	AllowInsecureTLS bool    `json:"allowInsecureTLS"`
+}
+
+func (c *apiClient) createRemote(ctx context.Context, r *remote) (*remote, error) {
+	var created remote
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/remotes", nil, r, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findRemoteByID(ctx context.Context, id string) (*remote, error) {
+	var r remote
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/remotes/"+id, nil, nil, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (c *apiClient) updateRemote(ctx context.Context, id string, update *remoteUpdate) (*remote, error) {
+	var r remote
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/remotes/"+id, nil, update, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (c *apiClient) deleteRemote(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/remotes/"+id, nil, nil, nil)
+}
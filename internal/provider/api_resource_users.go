@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// resourceUser is a user with a role on an object, as listed by the `/members` & `/owners`
+// sub-APIs of organizations, buckets, dashboards, etc.
+type resourceUser struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+	Status string `json:"status,omitempty"`
+}
+
+type resourceUsersResponse struct {
+	Users []resourceUser `json:"users"`
+}
+
+// findResourceUsers lists the users with the given role ("members" or "owners") on the
+// object at path, e.g. "/api/v2/orgs/{id}".
+func (c *apiClient) findResourceUsers(ctx context.Context, path string, role string) ([]resourceUser, error) {
+	var resp resourceUsersResponse
+	if err := c.doJSON(ctx, http.MethodGet, path+"/"+role, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// findResourceUser returns the user with the given role on the object at path. A user
+// without the role is reported as an *apiError with a 404 status code.
+func (c *apiClient) findResourceUser(ctx context.Context, path string, role string, userID string) (*resourceUser, error) {
+	users, err := c.findResourceUsers(ctx, path, role)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if users[i].ID == userID {
+			return &users[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "user \"" + userID + "\" not found in " + role,
+	}
+}
+
+func (c *apiClient) addResourceUser(ctx context.Context, path string, role string, userID string) error {
+	return c.doJSON(ctx, http.MethodPost, path+"/"+role, nil, domain.AddResourceMemberRequestBody{Id: userID}, nil)
+}
+
+func (c *apiClient) removeResourceUser(ctx context.Context, path string, role string, userID string) error {
+	return c.doJSON(ctx, http.MethodDelete, path+"/"+role+"/"+userID, nil, nil, nil)
+}
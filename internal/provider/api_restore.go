@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type restoreKVResponse struct {
+	// Token is the operator token of the restored KV store, when it differs from the token
+	// used to restore it.
+	Token string `json:"token,omitempty"`
+}
+
+type restoredBucket struct {
+	ID            string                `json:"id"`
+	Name          string                `json:"name"`
+	ShardMappings []restoreShardMapping `json:"shardMappings"`
+}
+
+type restoreShardMapping struct {
+	OldID int64 `json:"oldId"`
+	NewID int64 `json:"newId"`
+}
+
+// readBackupManifest reads a manifest of dir, or the latest one when name is empty. Backups
+// are named after their timestamp, so the latest is the last in name order.
+func readBackupManifest(dir string, name string) (string, *backupManifest, error) {
+	if name == "" {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.manifest"))
+		if err != nil {
+			return "", nil, err
+		}
+		if len(matches) == 0 {
+			return "", nil, fmt.Errorf("no backup manifest in (%s)", dir)
+		}
+		sort.Strings(matches)
+		name = filepath.Base(matches[len(matches)-1])
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", nil, err
+	}
+	var manifest backupManifest
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return "", nil, fmt.Errorf("unable to parse the backup manifest (%s): %v", name, err)
+	}
+	return name, &manifest, nil
+}
+
+// restoreKV replaces the KV store of the instance, i.e. all its metadata, and returns the
+// client to use for the rest of the restore, since the operator token can change with it.
+func (c *apiClient) restoreKV(ctx context.Context, dir string, f backupFile) (*apiClient, error) {
+	var res restoreKVResponse
+	if err := c.restoreFile(ctx, "/api/v2/restore/kv", dir, f, &res); err != nil {
+		return nil, err
+	}
+	if res.Token != "" && res.Token != c.token {
+		restored := *c
+		restored.token = res.Token
+		return &restored, nil
+	}
+	return c, nil
+}
+
+func (c *apiClient) restoreSQL(ctx context.Context, dir string, f backupFile) error {
+	return c.restoreFile(ctx, "/api/v2/restore/sql", dir, f, nil)
+}
+
+// restoreBucket creates a bucket of a backup with its shards, and uploads their data. It is
+// used when the KV store isn't restored, so the shards get new IDs.
+func (c *apiClient) restoreBucket(ctx context.Context, dir string, b backupBucketEntry) (*restoredBucket, error) {
+	var restored restoredBucket
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/restore/bucketMetadata", nil, b, &restored); err != nil {
+		return nil, err
+	}
+
+	files := backupShardFiles(b)
+	for _, m := range restored.ShardMappings {
+		f, ok := files[m.OldID]
+		if !ok {
+			continue
+		}
+		if err := c.restoreShard(ctx, dir, m.NewID, f); err != nil {
+			return nil, fmt.Errorf("unable to restore shard %d: %v", m.OldID, err)
+		}
+	}
+	return &restored, nil
+}
+
+// restoreShard uploads the data of a shard.
+func (c *apiClient) restoreShard(ctx context.Context, dir string, shardID int64, f backupFile) error {
+	return c.restoreFile(ctx, fmt.Sprintf("/api/v2/restore/shards/%d", shardID), dir, f, nil)
+}
+
+// backupShardFiles returns the files of the shards of a bucket of a backup, by shard ID.
+func backupShardFiles(b backupBucketEntry) map[int64]backupFile {
+	files := map[int64]backupFile{}
+	for _, rp := range b.RetentionPolicies {
+		for _, g := range rp.ShardGroups {
+			for _, s := range g.Shards {
+				if s.FileName != "" {
+					files[s.ID] = backupFile{FileName: s.FileName, Size: s.Size, Compression: s.Compression}
+				}
+			}
+		}
+	}
+	return files
+}
+
+// restoreFile uploads a file of a backup, as is: the API accepts gzip compressed bodies.
+func (c *apiClient) restoreFile(ctx context.Context, path string, dir string, f backupFile, out interface{}) error {
+	file, err := os.Open(filepath.Join(dir, f.FileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	req, err := c.newRequest(ctx, http.MethodPost, path, nil, file)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if strings.EqualFold(f.Compression, "gzip") {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	// the body is empty when there is nothing to report
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// script is an InfluxDB Cloud invokable script.
+type script struct {
+	ID          string    `json:"id"`
+	OrgID       string    `json:"orgID"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Script      string    `json:"script"`
+	Language    string    `json:"language"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type scriptCreate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Script      string `json:"script"`
+	Language    string `json:"language"`
+}
+
+// scriptUpdate has the only attributes of a script that can be changed.
+type scriptUpdate struct {
+	Description string `json:"description"`
+	Script      string `json:"script"`
+}
+
+func (c *apiClient) createScript(ctx context.Context, s *scriptCreate) (*script, error) {
+	var created script
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/scripts", nil, s, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findScriptByID(ctx context.Context, id string) (*script, error) {
+	var s script
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/scripts/"+id, nil, nil, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// findScripts returns all the scripts of the Organization of the token, requesting them a
+// page at a time.
+func (c *apiClient) findScripts(ctx context.Context) ([]script, error) {
+	var res []script
+	for offset := 0; ; offset += pageSize {
+		var resp struct {
+			Scripts []script `json:"scripts"`
+		}
+		query := url.Values{
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/scripts", query, nil, &resp); err != nil {
+			return nil, err
+		}
+		res = append(res, resp.Scripts...)
+		if len(resp.Scripts) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+func (c *apiClient) updateScript(ctx context.Context, id string, update *scriptUpdate) (*script, error) {
+	var s script
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/scripts/"+id, nil, update, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (c *apiClient) deleteScript(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/scripts/"+id, nil, nil, nil)
+}
+
+// findScriptParams returns the names of the parameters used by a script, e.g. `params.bucket`,
+// sorted by name.
+func (c *apiClient) findScriptParams(ctx context.Context, id string) ([]string, error) {
+	var resp struct {
+		Params map[string]interface{} `json:"params"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/scripts/"+id+"/params", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	params := make([]string, 0, len(resp.Params))
+	for k := range resp.Params {
+		params = append(params, k)
+	}
+	sort.Strings(params)
+	return params, nil
+}
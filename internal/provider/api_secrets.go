@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findSecretKeys returns the keys in an Organization's secret store. The values of secrets
+// are never returned by the API.
+func (c *apiClient) findSecretKeys(ctx context.Context, orgID string) ([]string, error) {
+	var resp domain.SecretKeysResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/orgs/"+orgID+"/secrets", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Secrets == nil {
+		return []string{}, nil
+	}
+	return *resp.Secrets, nil
+}
+
+// putSecrets adds or updates secrets in an Organization's secret store.
+func (c *apiClient) putSecrets(ctx context.Context, orgID string, secrets map[string]string) error {
+	return c.doJSON(ctx, http.MethodPatch, "/api/v2/orgs/"+orgID+"/secrets", nil, secrets, nil)
+}
+
+// deleteSecrets removes keys from an Organization's secret store.
+func (c *apiClient) deleteSecrets(ctx context.Context, orgID string, keys ...string) error {
+	return c.doJSON(ctx, http.MethodPost, "/api/v2/orgs/"+orgID+"/secrets/delete", nil, domain.SecretKeys{Secrets: &keys}, nil)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+)
+
+const signinPath = "/api/v2/signin"
+
+// newSessionAPIClient returns an API client authenticating with a username & password rather
+// than a token. signin must be called before the first request.
+func newSessionAPIClient(host string, username string, password string) *apiClient {
+	c := newAPIClient(host, "")
+	c.username = username
+	c.password = password
+	// cookiejar.New never fails without options
+	c.httpClient.Jar, _ = cookiejar.New(nil)
+	return c
+}
+
+// signin starts a session for the username & password of the client. The session cookie is
+// kept by the cookie jar of the HTTP client, which sends it with every following request.
+// InfluxDB Cloud doesn't support sessions for the API.
+func (c *apiClient) signin(ctx context.Context) error {
+	req, err := c.newRequest(ctx, http.MethodPost, signinPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+// retryWithNewSession signs in again and resends a request that was rejected because the
+// session expired. Requests with a body that can't be read again aren't retried.
+func (c *apiClient) retryWithNewSession(req *http.Request) (*http.Response, error) {
+	retry, err := rewind(req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] The session expired, signing in again")
+	if err := c.signin(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(retry)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] %s %s: %d%s", retry.Method, retry.URL.Path, resp.StatusCode, logFieldsSuffix(retry.Context()))
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSessionAuthentication(t *testing.T) {
+	session := 0
+	signins := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" && r.URL.Path != signinPath {
+			t.Errorf("unexpected Authorization header for %s", r.URL.Path)
+		}
+		switch r.URL.Path {
+		case signinPath:
+			if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "super-secret" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"code":"unauthorized","message":"Unauthorized"}`))
+				return
+			}
+			signins++
+			session++
+			http.SetCookie(w, &http.Cookie{Name: "influxdb-oss-session", Value: fmt.Sprint(session), Path: "/"})
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/me":
+			if c, err := r.Cookie("influxdb-oss-session"); err != nil || c.Value != fmt.Sprint(session) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"0000000000000001","name":"admin"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	ctx := context.Background()
+
+	api := newSessionAPIClient(srv.URL, "admin", "super-secret")
+	if err := api.signin(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if me, err := api.findMe(ctx); err != nil || me.Name != "admin" {
+		t.Fatalf("expected to be signed in as admin, got %v, %v", me, err)
+	}
+
+	// the session expires server side: the client signs in again
+	session++
+	if _, err := api.findMe(ctx); err != nil {
+		t.Fatalf("expected the session to be renewed, got: %v", err)
+	}
+	if signins != 2 {
+		t.Errorf("expected 2 signins, got %d", signins)
+	}
+
+	wrong := newSessionAPIClient(srv.URL, "admin", "wrong")
+	if err := wrong.signin(ctx); err == nil {
+		t.Fatal("expected the signin with a wrong password to fail")
+	}
+	if _, err := wrong.findMe(ctx); err == nil {
+		t.Fatal("expected a request without a session to fail")
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// setupRequest is domain.OnboardingRequest with the fields added by later InfluxDB 2.x
+// releases: a retention period in seconds and a caller chosen operator token.
+type setupRequest struct {
+	Username               string `json:"username"`
+	Password               string `json:"password,omitempty"`
+	Org                    string `json:"org"`
+	Bucket                 string `json:"bucket"`
+	RetentionPeriodSeconds int64  `json:"retentionPeriodSeconds,omitempty"`
+	Token                  string `json:"token,omitempty"`
+}
+
+// isSetupAllowed reports whether the instance still has to be onboarded.
+func (c *apiClient) isSetupAllowed(ctx context.Context) (bool, error) {
+	var resp domain.IsOnboarding
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/setup", nil, nil, &resp); err != nil {
+		return false, err
+	}
+	return resp.Allowed != nil && *resp.Allowed, nil
+}
+
+// setup onboards a fresh instance, creating the initial user, Organization, bucket & operator
+// token. It can only succeed once per instance.
+func (c *apiClient) setup(ctx context.Context, req *setupRequest) (*domain.OnboardingResponse, error) {
+	var resp domain.OnboardingResponse
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/setup", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
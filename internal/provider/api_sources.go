@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// source is a legacy, Chronograf style data source, referenced by some dashboards. The API
+// doesn't return the password, token & shared secret.
+type source struct {
+	ID                 string   `json:"id,omitempty"`
+	OrgID              string   `json:"orgID"`
+	Default            bool     `json:"default"`
+	Name               string   `json:"name"`
+	Type               string   `json:"type"`
+	URL                string   `json:"url"`
+	InsecureSkipVerify bool     `json:"insecureSkipVerify"`
+	Telegraf           string   `json:"telegraf"`
+	Username           string   `json:"username"`
+	Password           string   `json:"password,omitempty"`
+	Token              string   `json:"token,omitempty"`
+	SharedSecret       string   `json:"sharedSecret,omitempty"`
+	MetaURL            string   `json:"metaUrl"`
+	DefaultRP          string   `json:"defaultRP"`
+	Languages          []string `json:"languages,omitempty"`
+}
+
+// sourceUpdate only sends the password, token & shared secret when they changed.
+type sourceUpdate struct {
+	Default            bool    `json:"default"`
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	URL                string  `json:"url"`
+	InsecureSkipVerify bool    `json:"insecureSkipVerify"`
+	Telegraf           string  `json:"telegraf"`
+	Username           string  `json:"username"`
+	Password           *string `json:"password,omitempty"`
+	Token              *string `json:"token,omitempty"`
+	SharedSecret       *string `json:"sharedSecret,omitempty"`
+	MetaURL            string  `json:"metaUrl"`
+	DefaultRP          string  `json:"defaultRP"`
+}
+
+func (c *apiClient) createSource(ctx context.Context, s *source) (*source, error) {
+	var created source
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/sources", nil, s, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findSourceByID(ctx context.Context, id string) (*source, error) {
+	var s source
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/sources/"+id, nil, nil, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (c *apiClient) updateSource(ctx context.Context, id string, update *sourceUpdate) (*source, error) {
+	var s source
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/sources/"+id, nil, update, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (c *apiClient) deleteSource(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/sources/"+id, nil, nil, nil)
+}
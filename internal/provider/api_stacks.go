@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// stack is an InfluxDB2 stack, which tracks the resources created by applying templates.
+type stack struct {
+	ID          string
+	OrgID       string
+	Name        string
+	Description string
+	URLs        []string
+	Resources   []stackResource
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type stackResource struct {
+	Kind       string `json:"kind"`
+	MetaName   string `json:"templateMetaName"`
+	ResourceID string `json:"resourceID"`
+	// PkgName is the name of MetaName before 2.0.0
+	PkgName string `json:"pkgName"`
+}
+
+type stackEvent struct {
+	EventType   string          `json:"eventType"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	URLs        []string        `json:"urls"`
+	Resources   []stackResource `json:"resources"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// stackResponse covers both shapes of the stacks API: releases before 2.0.0 return the
+// stack's attributes directly (see domain.Stack), later ones as a list of events, of which
+// the last one is current.
+type stackResponse struct {
+	ID          string          `json:"id"`
+	OrgID       string          `json:"orgID"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Events      []stackEvent    `json:"events"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	URLs        []string        `json:"urls"`
+	Resources   []stackResource `json:"resources"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+func (r *stackResponse) stack() *stack {
+	s := &stack{
+		ID:          r.ID,
+		OrgID:       r.OrgID,
+		CreatedAt:   r.CreatedAt,
+		Name:        r.Name,
+		Description: r.Description,
+		URLs:        r.URLs,
+		Resources:   r.Resources,
+		UpdatedAt:   r.UpdatedAt,
+	}
+	if len(r.Events) > 0 {
+		latest := r.Events[len(r.Events)-1]
+		s.Name = latest.Name
+		s.Description = latest.Description
+		s.URLs = latest.URLs
+		s.Resources = latest.Resources
+		s.UpdatedAt = latest.UpdatedAt
+	}
+	for i := range s.Resources {
+		if s.Resources[i].MetaName == "" {
+			s.Resources[i].MetaName = s.Resources[i].PkgName
+		}
+	}
+	sort.SliceStable(s.Resources, func(i, j int) bool {
+		if s.Resources[i].Kind != s.Resources[j].Kind {
+			return s.Resources[i].Kind < s.Resources[j].Kind
+		}
+		return s.Resources[i].MetaName < s.Resources[j].MetaName
+	})
+	return s
+}
+
+type stackCreate struct {
+	OrgID       string   `json:"orgID"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	URLs        []string `json:"urls"`
+}
+
+// stackUpdate sends the template URLs under both names used by the API: `urls` before
+// 2.0.0 and `templateURLs` since. Each release ignores the other field.
+type stackUpdate struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	URLs         []string `json:"urls"`
+	TemplateURLs []string `json:"templateURLs"`
+}
+
+func (c *apiClient) createStack(ctx context.Context, create *stackCreate) (*stack, error) {
+	var resp stackResponse
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/stacks", nil, create, &resp); err != nil {
+		return nil, err
+	}
+	return resp.stack(), nil
+}
+
+func (c *apiClient) findStackByID(ctx context.Context, id string) (*stack, error) {
+	var resp stackResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/stacks/"+id, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.stack(), nil
+}
+
+// findStacks returns all the stacks of an Organization. The API doesn't page them.
+func (c *apiClient) findStacks(ctx context.Context, orgID string) ([]stack, error) {
+	var resp struct {
+		Stacks []stackResponse `json:"stacks"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/stacks", url.Values{"orgID": []string{orgID}}, nil, &resp); err != nil {
+		return nil, err
+	}
+	res := make([]stack, 0, len(resp.Stacks))
+	for i := range resp.Stacks {
+		res = append(res, *resp.Stacks[i].stack())
+	}
+	return res, nil
+}
+
+func (c *apiClient) updateStack(ctx context.Context, id string, update *stackUpdate) (*stack, error) {
+	var resp stackResponse
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v2/stacks/"+id, nil, update, &resp); err != nil {
+		return nil, err
+	}
+	return resp.stack(), nil
+}
+
+// deleteStack deletes a stack together with all the resources it tracks.
+func (c *apiClient) deleteStack(ctx context.Context, orgID string, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v2/stacks/"+id, url.Values{"orgID": []string{orgID}}, nil, nil)
+}
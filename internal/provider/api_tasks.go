@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// runTask starts a manual run of a task, now or at scheduledFor when set.
+func (c *apiClient) runTask(ctx context.Context, taskID string, run *domain.RunManually) (*domain.Run, error) {
+	var created domain.Run
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/tasks/"+taskID+"/runs", nil, run, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findTaskRun(ctx context.Context, taskID string, runID string) (*domain.Run, error) {
+	var run domain.Run
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/tasks/"+taskID+"/runs/"+runID, nil, nil, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (c *apiClient) findTaskRunLogs(ctx context.Context, taskID string, runID string) ([]domain.LogEvent, error) {
+	var logs domain.Logs
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/tasks/"+taskID+"/runs/"+runID+"/logs", nil, nil, &logs); err != nil {
+		return nil, err
+	}
+	if logs.Events == nil {
+		return nil, nil
+	}
+	return *logs.Events, nil
+}
+
+// isTaskRunDone returns whether a run has finished, successfully or not.
+func isTaskRunDone(run *domain.Run) bool {
+	if run.Status == nil {
+		return false
+	}
+	switch *run.Status {
+	case domain.RunStatusSuccess, domain.RunStatusFailed, domain.RunStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// tasksFilter restricts the tasks returned by findTasks. Empty fields don't filter.
+type tasksFilter struct {
+	orgID  string
+	userID string
+	status string
+	// limit is the maximum number of tasks returned, 0 for all
+	limit int
+}
+
+// findTasks returns the tasks the token can read in ID order, requesting them a page at a
+// time. The API pages tasks by the last ID of the previous page.
+func (c *apiClient) findTasks(ctx context.Context, filter tasksFilter) ([]domain.Task, error) {
+	query := url.Values{}
+	for k, v := range map[string]string{
+		"orgID":  filter.orgID,
+		"user":   filter.userID,
+		"status": filter.status,
+	} {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+
+	var res []domain.Task
+	for {
+		limit := pageSize
+		if filter.limit > 0 && filter.limit-len(res) < limit {
+			limit = filter.limit - len(res)
+		}
+		query.Set("limit", strconv.Itoa(limit))
+
+		var tasks domain.Tasks
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/tasks", query, nil, &tasks); err != nil {
+			return nil, err
+		}
+		if tasks.Tasks == nil {
+			return res, nil
+		}
+		res = append(res, *tasks.Tasks...)
+		if len(*tasks.Tasks) < limit || len(res) == filter.limit {
+			return res, nil
+		}
+		query.Set("after", res[len(res)-1].Id)
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// telegraf is a Telegraf configuration stored in InfluxDB2, which agents can load with
+// `telegraf --config <host>/api/v2/telegrafs/<id>`.
+type telegraf struct {
+	ID          string           `json:"id"`
+	OrgID       string           `json:"orgID"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Metadata    telegrafMetadata `json:"metadata"`
+	// Config is the TOML of the configuration
+	Config string         `json:"config"`
+	Labels []domain.Label `json:"labels"`
+}
+
+type telegrafMetadata struct {
+	Buckets []string `json:"buckets"`
+}
+
+type telegrafs struct {
+	Configurations []telegraf `json:"configurations"`
+}
+
+func (c *apiClient) findTelegrafByID(ctx context.Context, id string) (*telegraf, error) {
+	var t telegraf
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/telegrafs/"+id, nil, nil, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// findTelegrafs returns all the Telegraf configurations of an Organization. The API
+// doesn't page them.
+func (c *apiClient) findTelegrafs(ctx context.Context, orgID string) ([]telegraf, error) {
+	var res telegrafs
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/telegrafs", url.Values{"orgID": []string{orgID}}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Configurations, nil
+}
+
+// findTelegrafByName returns the Telegraf configuration of an Organization with the given
+// name. The API can't filter configurations by name, so a missing configuration is
+// reported as an *apiError with a 404 status code.
+func (c *apiClient) findTelegrafByName(ctx context.Context, orgID string, name string) (*telegraf, error) {
+	all, err := c.findTelegrafs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].Name == name {
+			return &all[i], nil
+		}
+	}
+	return nil, &apiError{
+		StatusCode: http.StatusNotFound,
+		Code:       string(domain.ErrorCodeNotFound),
+		Message:    "telegraf configuration name \"" + name + "\" not found",
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type templateApply struct {
+	OrgID    string            `json:"orgID"`
+	StackID  string            `json:"stackID,omitempty"`
+	DryRun   bool              `json:"dryRun"`
+	Template *templateContents `json:"template,omitempty"`
+	Remotes  []templateRemote  `json:"remotes,omitempty"`
+	EnvRefs  map[string]string `json:"envRefs,omitempty"`
+}
+
+type templateContents struct {
+	ContentType string          `json:"contentType"`
+	Contents    json.RawMessage `json:"contents"`
+}
+
+type templateRemote struct {
+	URL string `json:"url"`
+}
+
+type templateSummary struct {
+	StackID string `json:"stackID"`
+}
+
+// applyTemplate applies a template to an Organization, creating or updating the stack that
+// tracks its resources, and returns the ID of the stack.
+func (c *apiClient) applyTemplate(ctx context.Context, apply *templateApply) (string, error) {
+	var resp templateSummary
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/templates/apply", nil, apply, &resp); err != nil {
+		return "", err
+	}
+	return resp.StackID, nil
+}
+
+type templateExport struct {
+	Resources []templateExportResource `json:"resources"`
+}
+
+type templateExportResource struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+// exportTemplate exports existing resources as the objects of a template, in JSON.
+func (c *apiClient) exportTemplate(ctx context.Context, export *templateExport) (json.RawMessage, error) {
+	var objects json.RawMessage
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v2/templates/export", nil, export, &objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// templateYAML converts the objects of a template from JSON to YAML, one document per
+// object, the format of the templates exported by the influx CLI.
+func templateYAML(objects json.RawMessage) (string, error) {
+	// numbers are decoded as json.Number, so that integers such as retention periods aren't
+	// written as floats, e.g. 2.592e+06
+	dec := json.NewDecoder(bytes.NewReader(objects))
+	dec.UseNumber()
+	var list []interface{}
+	if err := dec.Decode(&list); err != nil {
+		return "", err
+	}
+
+	docs := make([]string, 0, len(list))
+	for _, obj := range list {
+		b, err := yaml.Marshal(yamlNumbers(obj))
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, string(b))
+	}
+	return strings.Join(docs, "---\n"), nil
+}
+
+// yamlNumbers converts the json.Number values of decoded JSON to int64 or float64, which
+// yaml.v2 writes as numbers rather than strings.
+func yamlNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			v[k] = yamlNumbers(val)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = yamlNumbers(v[i])
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// parseTemplate converts a template in YAML (one or more documents) or JSON to the list of
+// objects expected in the `contents` of a template apply request.
+func parseTemplate(template string) (json.RawMessage, error) {
+	objects := []interface{}{}
+
+	dec := yaml.NewDecoder(bytes.NewReader([]byte(template)))
+	for {
+		var doc interface{}
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := normalizeYAML(doc).(type) {
+		case nil:
+		case []interface{}:
+			objects = append(objects, v...)
+		case map[string]interface{}:
+			objects = append(objects, v)
+		default:
+			return nil, fmt.Errorf("expected template objects, got %T", v)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("template has no objects")
+	}
+
+	return json.Marshal(objects)
+}
+
+// normalizeYAML converts the map[interface{}]interface{} values decoded by yaml.v2 to
+// map[string]interface{}, so they can be encoded as JSON.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = normalizeYAML(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeYAML(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
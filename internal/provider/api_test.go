@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testAPIServer returns an apiClient for a server that always answers with the given response.
+func testAPIServer(t *testing.T, status int, contentType string, body string) *apiClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return newAPIClient(srv.URL, "token")
+}
+
+func TestErrorClassification(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		notFound    bool
+		conflict    bool
+	}{
+		{"json not found", 404, "application/json", `{"code":"not found","message":"organization not found"}`, true, false},
+		{"localized not found", 404, "application/json", `{"code":"not found","message":"organisation introuvable"}`, true, false},
+		{"plain text localized 404", 404, "text/plain", `Nicht gefunden`, true, false},
+		{"code without status", 400, "application/json", `{"code":"not found","message":"bucket introuvable"}`, true, false},
+		{"english message with other code", 400, "application/json", `{"code":"invalid","message":"field not found"}`, false, false},
+		{"conflict", 422, "application/json", `{"code":"conflict","message":"nom déjà utilisé"}`, false, true},
+		{"conflict status", 409, "", ``, false, true},
+		{"server error", 500, "text/html", `<html>not found</html>`, false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			api := testAPIServer(t, c.status, c.contentType, c.body)
+			err := api.doJSON(context.Background(), http.MethodGet, "/api/v2/orgs/0000000000000000", nil, nil, nil)
+
+			var apiErr *apiError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != c.status {
+				t.Fatalf("expected an *apiError with status %d, got: %v", c.status, err)
+			}
+			if isNotFound(err) != c.notFound {
+				t.Errorf("isNotFound = %v, expected %v for: %v", !c.notFound, c.notFound, err)
+			}
+			if isConflict(err) != c.conflict {
+				t.Errorf("isConflict = %v, expected %v for: %v", !c.conflict, c.conflict, err)
+			}
+		})
+	}
+}
+
+func TestErrorClassificationNonAPIErrors(t *testing.T) {
+	if isNotFound(errors.New("not found")) {
+		t.Fatal("plain errors must not be classified by their message")
+	}
+	if isNotFound(nil) {
+		t.Fatal("nil must not be classified as not found")
+	}
+}
+
+func TestFindOrganizationByNameNotFound(t *testing.T) {
+	api := testAPIServer(t, 200, "application/json", `{"orgs":[]}`)
+
+	_, err := api.findOrganizationByName(context.Background(), "missing")
+	if !isNotFound(err) {
+		t.Fatalf("expected a not found error for an empty result, got: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// findUserByID returns the user with the given ID. A missing user is reported as an
+// *apiError with a 404 status code.
+func (c *apiClient) findUserByID(ctx context.Context, id string) (*domain.User, error) {
+	var u domain.User
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/users/"+id, nil, nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// findMe returns the user that owns the token the provider is configured with.
+func (c *apiClient) findMe(ctx context.Context) (*domain.User, error) {
+	var u domain.User
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/me", nil, nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// findUserByName returns the user with the given name. When no user matches, an *apiError
+// with a 404 status code is returned, the same as for a lookup by ID.
+func (c *apiClient) findUserByName(ctx context.Context, name string) (*domain.User, error) {
+	var users domain.Users
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/users", url.Values{"name": []string{name}}, nil, &users); err != nil {
+		return nil, err
+	}
+	if users.Users == nil || len(*users.Users) == 0 {
+		return nil, &apiError{
+			StatusCode: http.StatusNotFound,
+			Code:       string(domain.ErrorCodeNotFound),
+			Message:    "user name \"" + name + "\" not found",
+		}
+	}
+	return &(*users.Users)[0], nil
+}
+
+// findUsers returns all the users the token can read, requesting them a page at a time.
+func (c *apiClient) findUsers(ctx context.Context) ([]domain.User, error) {
+	var res []domain.User
+	for offset := 0; ; offset += pageSize {
+		var users domain.Users
+		query := url.Values{
+			"limit":  []string{strconv.Itoa(pageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/api/v2/users", query, nil, &users); err != nil {
+			return nil, err
+		}
+		if users.Users == nil {
+			return res, nil
+		}
+		res = append(res, *users.Users...)
+		if len(*users.Users) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+func userStatus(u domain.User) string {
+	if u.Status == nil {
+		return string(domain.UserStatusActive)
+	}
+	return string(*u.Status)
+}
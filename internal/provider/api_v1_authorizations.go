@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// v1Authorization is a legacy authorization, used by InfluxDB 1.x clients to authenticate with
+// a username & password against the 1.x compatibility API. The API calls the username the
+// token, and never returns the password.
+type v1Authorization struct {
+	ID          string              `json:"id"`
+	OrgID       string              `json:"orgID"`
+	UserID      string              `json:"userID"`
+	Username    string              `json:"token"`
+	Description string              `json:"description"`
+	Status      string              `json:"status"`
+	Permissions []domain.Permission `json:"permissions"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   time.Time           `json:"updatedAt"`
+}
+
+type v1AuthorizationCreate struct {
+	OrgID       string              `json:"orgID"`
+	UserID      string              `json:"userID,omitempty"`
+	Username    string              `json:"token"`
+	Description string              `json:"description"`
+	Status      string              `json:"status"`
+	Permissions []domain.Permission `json:"permissions"`
+}
+
+type v1AuthorizationUpdate struct {
+	Description string `json:"description"`
+	Status      string `json:"status"`
+}
+
+// The legacy authorizations API is private to InfluxDB2 OSS.
+const v1AuthorizationsPath = "/private/legacy/authorizations"
+
+func (c *apiClient) createV1Authorization(ctx context.Context, a *v1AuthorizationCreate) (*v1Authorization, error) {
+	var created v1Authorization
+	if err := c.doJSON(ctx, http.MethodPost, v1AuthorizationsPath, nil, a, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *apiClient) findV1AuthorizationByID(ctx context.Context, id string) (*v1Authorization, error) {
+	var a v1Authorization
+	if err := c.doJSON(ctx, http.MethodGet, v1AuthorizationsPath+"/"+id, nil, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (c *apiClient) updateV1Authorization(ctx context.Context, id string, update *v1AuthorizationUpdate) (*v1Authorization, error) {
+	var a v1Authorization
+	if err := c.doJSON(ctx, http.MethodPatch, v1AuthorizationsPath+"/"+id, nil, update, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (c *apiClient) setV1AuthorizationPassword(ctx context.Context, id string, password string) error {
+	body := struct {
+		Password string `json:"password"`
+	}{password}
+	return c.doJSON(ctx, http.MethodPost, v1AuthorizationsPath+"/"+id+"/password", nil, body, nil)
+}
+
+func (c *apiClient) deleteV1Authorization(ctx context.Context, id string) error {
+	return c.doJSON(ctx, http.MethodDelete, v1AuthorizationsPath+"/"+id, nil, nil, nil)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// variable is a dashboard variable. The values of its arguments depend on their type:
+// a list of strings for `constant`, a map for `map` and a query for `query`.
+type variable struct {
+	ID          string            `json:"id"`
+	OrgID       string            `json:"orgID"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Selected    []string          `json:"selected"`
+	Arguments   variableArguments `json:"arguments"`
+	Labels      []domain.Label    `json:"labels"`
+}
+
+type variableArguments struct {
+	Type   string          `json:"type"`
+	Values json.RawMessage `json:"values"`
+}
+
+type variableQuery struct {
+	Query    string `json:"query"`
+	Language string `json:"language"`
+}
+
+type variables struct {
+	Variables []variable `json:"variables"`
+}
+
+// findVariables returns all the variables of an Organization. The API doesn't page them.
+func (c *apiClient) findVariables(ctx context.Context, orgID string) ([]variable, error) {
+	var res variables
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v2/variables", url.Values{"orgID": []string{orgID}}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Variables, nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// write writes line protocol to a bucket. The body is gzip compressed, since seeding data
+// can be large and /api/v2/write accepts compressed bodies.
+func (c *apiClient) write(ctx context.Context, orgID string, bucketID string, precision string, lineProtocol []byte) error {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(lineProtocol); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	query := url.Values{
+		"orgID":     []string{orgID},
+		"bucket":    []string{bucketID},
+		"precision": []string{precision},
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/api/v2/write", query, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	req.Header.Set("Content-Encoding", "gzip")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// auditLogger appends a JSON line to a local file for every mutating resource operation,
+// when `audit_log_file` is set on the provider.
+type auditLogger struct {
+	path string
+	api  *apiClient
+
+	mu        sync.Mutex
+	actorOnce sync.Once
+	actor     string
+}
+
+type auditRecord struct {
+	Time         time.Time `json:"time"`
+	ResourceType string    `json:"resource_type"`
+	Action       string    `json:"action"`
+	ID           string    `json:"id"`
+	Actor        string    `json:"actor"`
+	DurationMS   int64     `json:"duration_ms"`
+	Outcome      string    `json:"outcome"`
+	Error        string    `json:"error,omitempty"`
+}
+
+func newAuditLogger(path string, api *apiClient) *auditLogger {
+	return &auditLogger{
+		path: path,
+		api:  api,
+	}
+}
+
+// resolveActor returns the name of the user that owns the provider's credentials. It is
+// looked up once; if the lookup fails the actor is recorded as empty.
+func (l *auditLogger) resolveActor(ctx context.Context) string {
+	l.actorOnce.Do(func() {
+		me, err := l.api.findMe(ctx)
+		if err != nil {
+			log.Printf("[WARN] unable to determine the audit log actor: %v", err)
+			return
+		}
+		l.actor = me.Name
+	})
+	return l.actor
+}
+
+func (l *auditLogger) write(rec auditRecord) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// auditResources wraps the create, update & delete functions of every resource so that
+// each call is recorded by the audit logger.
+func auditResources(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(withAudit(name, "create", r.CreateContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(withAudit(name, "update", r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(withAudit(name, "delete", r.DeleteContext))
+		}
+	}
+}
+
+func withAudit(resourceType string, action string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		md, ok := meta.(*metaData)
+		if !ok || md.audit == nil {
+			return fn(ctx, d, meta)
+		}
+
+		// the ID is gone after a delete, and only known after a create
+		id := d.Id()
+		start := time.Now()
+		diags := fn(ctx, d, meta)
+		if id == "" {
+			id = d.Id()
+		}
+
+		rec := auditRecord{
+			Time:         start.UTC(),
+			ResourceType: resourceType,
+			Action:       action,
+			ID:           id,
+			Actor:        md.audit.resolveActor(ctx),
+			DurationMS:   time.Since(start).Milliseconds(),
+			Outcome:      "success",
+		}
+		if diags.HasError() {
+			rec.Outcome = "error"
+			for _, diagnostic := range diags {
+				if diagnostic.Severity == diag.Error {
+					rec.Error = diagnostic.Summary
+					break
+				}
+			}
+		}
+
+		if err := md.audit.write(rec); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to write to the audit log",
+				Detail:   err.Error(),
+			})
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAuditLog(t *testing.T) {
+	api := testAPIServer(t, 200, "application/json", `{"id":"0000000000000001","name":"admin"}`)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	md := &metaData{api: api, audit: newAuditLogger(path, api)}
+
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("0000000000000002")
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Errorf("unable to delete")
+		},
+	}
+	auditResources(map[string]*schema.Resource{"influxdb2_test": res})
+
+	d := res.TestResourceData()
+	if diags := res.CreateContext(context.Background(), d, md); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags := res.DeleteContext(context.Background(), d, md); !diags.HasError() {
+		t.Fatal("expected the delete error to be returned")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 audit records, got:\n%s", b)
+	}
+
+	var create, del auditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &create); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &del); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if create.ResourceType != "influxdb2_test" || create.Action != "create" || create.ID != "0000000000000002" || create.Actor != "admin" || create.Outcome != "success" {
+		t.Errorf("unexpected create record: %+v", create)
+	}
+	if del.Action != "delete" || del.ID != "0000000000000002" || del.Outcome != "error" || del.Error != "unable to delete" {
+		t.Errorf("unexpected delete record: %+v", del)
+	}
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	called := false
+	fn := withAudit("influxdb2_test", "create", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	})
+	if diags := fn(context.Background(), nil, &metaData{}); diags.HasError() || !called {
+		t.Fatalf("expected the wrapped function to be called without auditing, got: %v", diags)
+	}
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceAllBuckets() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the buckets of every Organization the provider's token can read, e.g. to express instance-wide policies such as \"every bucket must have a finite retention\" as Terraform checks. Organizations are read concurrently, up to `concurrency` at a time.",
+
+		ReadContext: dataSourceAllBucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"include_system": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to include the system buckets, e.g. `_monitoring`.",
+			},
+			"concurrency": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          4,
+				ValidateDiagFunc: validateIntBetween(1, 16),
+				Description:      "Maximum number of Organizations whose buckets are read at the same time.",
+			},
+			// Computed outputs
+			"buckets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The buckets, sorted by Organization name & bucket name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Organization that owns the bucket.",
+						},
+						"org_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Organization that owns the bucket.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the bucket.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the bucket.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the bucket: `user` or `system`.",
+						},
+						"retention_period": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Duration in seconds for how long data is kept in the bucket, `0` for infinite retention.",
+						},
+						"has_finite_retention": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether data is deleted from the bucket after `retention_period`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// orgBucket is a bucket with the Organization that owns it.
+type orgBucket struct {
+	org    domain.Organization
+	bucket domain.Bucket
+}
+
+func dataSourceAllBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading the buckets of all Organizations")
+
+	all, err := findAllBuckets(ctx, api, d.Get("concurrency").(int))
+	if err != nil {
+		return diag.Errorf("unable to retrieve buckets: %v", err)
+	}
+
+	includeSystem := d.Get("include_system").(bool)
+
+	buckets := make([]interface{}, 0, len(all))
+	hash := sha256.New()
+	for _, ob := range all {
+		if ob.bucket.Type != nil && *ob.bucket.Type == domain.BucketTypeSystem && !includeSystem {
+			continue
+		}
+		buckets = append(buckets, map[string]interface{}{
+			"org_id":               stringValue(ob.org.Id),
+			"org_name":             ob.org.Name,
+			"id":                   stringValue(ob.bucket.Id),
+			"name":                 ob.bucket.Name,
+			"type":                 bucketType(ob.bucket),
+			"retention_period":     bucketRetentionPeriod(ob.bucket),
+			"has_finite_retention": bucketRetentionPeriod(ob.bucket) > 0,
+		})
+		fmt.Fprintf(hash, "%s/%s\n", stringValue(ob.org.Id), stringValue(ob.bucket.Id))
+	}
+
+	log.Printf("[INFO] Found %d buckets", len(buckets))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("buckets", buckets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// findAllBuckets returns the buckets of all Organizations, sorted by Organization name &
+// bucket name. Up to concurrency Organizations are read at the same time; the first error
+// cancels the others.
+func findAllBuckets(ctx context.Context, api *apiClient, concurrency int) ([]orgBucket, error) {
+	orgs, err := api.findOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		res      []orgBucket
+		sem      = make(chan struct{}, concurrency)
+	)
+	for _, org := range orgs {
+		org := org
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			buckets, err := api.findBuckets(ctx, stringValue(org.Id))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("Organization (%s): %v", org.Name, err)
+					cancel()
+				}
+				return
+			}
+			for _, b := range buckets {
+				res = append(res, orgBucket{org: org, bucket: b})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].org.Name != res[j].org.Name {
+			return res[i].org.Name < res[j].org.Name
+		}
+		return res[i].bucket.Name < res[j].bucket.Name
+	})
+	return res, nil
+}
+
+func bucketType(b domain.Bucket) string {
+	if b.Type == nil {
+		return string(domain.BucketTypeUser)
+	}
+	return string(*b.Type)
+}
+
+// bucketRetentionPeriod returns the retention of a bucket in seconds, 0 when infinite.
+func bucketRetentionPeriod(b domain.Bucket) int {
+	for _, r := range b.RetentionRules {
+		if r.Type == domain.RetentionRuleTypeExpire {
+			return r.EverySeconds
+		}
+	}
+	return 0
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The docker-compose test server only has a single Organization, so paging & concurrency
+// are tested against a fake server with more Organizations than fit in a page.
+func TestFindAllBuckets(t *testing.T) {
+	const orgCount = pageSize + 5
+	var inFlight, maxInFlight int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/orgs", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var orgs []map[string]string
+		for i := offset; i < orgCount && i < offset+pageSize; i++ {
+			orgs = append(orgs, map[string]string{"id": fmt.Sprintf("%016x", i), "name": fmt.Sprintf("org-%03d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"orgs": orgs})
+	})
+	mux.HandleFunc("/api/v2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		orgID := r.URL.Query().Get("orgID")
+		json.NewEncoder(w).Encode(map[string]interface{}{"buckets": []map[string]interface{}{
+			{"id": orgID + "-b", "name": "b", "type": "user", "retentionRules": []map[string]interface{}{{"type": "expire", "everySeconds": 3600}}},
+			{"id": orgID + "-a", "name": "a", "type": "system", "retentionRules": []map[string]interface{}{}},
+		}})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	all, err := findAllBuckets(context.Background(), newAPIClient(srv.URL, "token"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all) != 2*orgCount {
+		t.Fatalf("expected %d buckets, got %d", 2*orgCount, len(all))
+	}
+	if all[0].org.Name != "org-000" || all[0].bucket.Name != "a" || all[1].bucket.Name != "b" {
+		t.Errorf("expected buckets sorted by Organization & bucket name, got %s/%s, %s/%s", all[0].org.Name, all[0].bucket.Name, all[1].org.Name, all[1].bucket.Name)
+	}
+	if all[len(all)-1].org.Name != fmt.Sprintf("org-%03d", orgCount-1) {
+		t.Errorf("expected the Organizations of the last page, got %s", all[len(all)-1].org.Name)
+	}
+	if bucketRetentionPeriod(all[1].bucket) != 3600 || bucketRetentionPeriod(all[0].bucket) != 0 {
+		t.Errorf("unexpected retention periods: %d, %d", bucketRetentionPeriod(all[1].bucket), bucketRetentionPeriod(all[0].bucket))
+	}
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestAccDataSourceAllBuckets(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					data "influxdb2_all_buckets" "all" {
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_all_buckets.all", "buckets.*", map[string]string{
+						"org_name": testInitialOrg,
+						"name":     testInitialBucket,
+						"type":     "user",
+					}),
+				),
+			},
+			{
+				Config: testConfig(`
+					data "influxdb2_all_buckets" "all" {
+						include_system = true
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_all_buckets.all", "buckets.*", map[string]string{
+						"org_name":             testInitialOrg,
+						"name":                 "_monitoring",
+						"type":                 "system",
+						"has_finite_retention": "true",
+					}),
+				),
+			},
+		},
+	})
+}
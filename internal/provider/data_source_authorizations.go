@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceAuthorizations() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the authorizations, i.e. API tokens, the provider's token can read, optionally filtered by user & Organization, e.g. to audit token sprawl with Terraform checks. The tokens themselves are never returned.",
+
+		ReadContext: dataSourceAuthorizationsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_name"},
+				Description:   "Only list the authorizations of the user with this ID.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the authorizations of the user with this name.",
+			},
+			"org_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"org_name"},
+				Description:   "Only list the authorizations of the Organization with this ID.",
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the authorizations of the Organization with this name.",
+			},
+			// Computed outputs
+			"authorizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The authorizations, sorted by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the authorization.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the authorization.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the authorization, `active` or `inactive`.",
+						},
+						"org_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Organization of the authorization.",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user of the authorization.",
+						},
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user of the authorization.",
+						},
+						"permissions": permissionsOutputSchema("The permissions granted by the authorization."),
+						"permissions_summary": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The permissions in short, e.g. `write:buckets, read:buckets/0123456789abcdef`, with the resource ID when restricted to a single resource.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the authorization was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// summarizePermissions returns the permissions in short, in canonical order.
+func summarizePermissions(permissions []domain.Permission) string {
+	sorted := make([]domain.Permission, len(permissions))
+	copy(sorted, permissions)
+	sortPermissions(sorted)
+
+	parts := make([]string, 0, len(sorted))
+	for _, p := range sorted {
+		part := string(p.Action) + ":" + string(p.Resource.Type)
+		if id := stringValue(p.Resource.Id); id != "" {
+			part += "/" + id
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func dataSourceAuthorizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	filter := authorizationsFilter{
+		userID:   d.Get("user_id").(string),
+		userName: d.Get("user_name").(string),
+		orgID:    d.Get("org_id").(string),
+		orgName:  d.Get("org_name").(string),
+	}
+
+	log.Printf("[INFO] Reading authorizations (%+v)", filter)
+
+	all, err := api.findAuthorizations(ctx, filter)
+	if err != nil {
+		return diag.Errorf("unable to retrieve authorizations: %v", err)
+	}
+	sort.Slice(all, func(i, j int) bool { return stringValue(all[i].Id) < stringValue(all[j].Id) })
+
+	auths := make([]interface{}, 0, len(all))
+	hash := sha256.New()
+	for _, a := range all {
+		var permissions []domain.Permission
+		if a.Permissions != nil {
+			permissions = *a.Permissions
+		}
+		status := string(domain.AuthorizationUpdateRequestStatusActive)
+		if a.Status != nil {
+			status = string(*a.Status)
+		}
+		createdAt := ""
+		if a.CreatedAt != nil {
+			createdAt = a.CreatedAt.UTC().String()
+		}
+		auths = append(auths, map[string]interface{}{
+			"id":                  stringValue(a.Id),
+			"description":         stringValue(a.Description),
+			"status":              status,
+			"org_id":              stringValue(a.OrgID),
+			"user_id":             stringValue(a.UserID),
+			"user_name":           stringValue(a.User),
+			"permissions":         flattenPermissions(permissions),
+			"permissions_summary": summarizePermissions(permissions),
+			"created_at":          createdAt,
+		})
+		fmt.Fprintf(hash, "%s\n", stringValue(a.Id))
+	}
+
+	log.Printf("[INFO] Found %d authorizations", len(auths))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("authorizations", auths); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func TestAccDataSourceAuthorizations(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					data "influxdb2_authorizations" "initial" {
+						org_name = "` + testInitialOrg + `"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_authorizations.initial", "authorizations.*", map[string]string{
+						"status": "active",
+					}),
+					resource.TestCheckNoResourceAttr("data.influxdb2_authorizations.initial", "authorizations.0.token"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceAuthorizationsRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Encode(); got != "orgID=0000000000000001&user=alice" {
+			t.Errorf("unexpected query %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"authorizations":[{
+			"id": "0000000000000003",
+			"token": "secret",
+			"status": "inactive",
+			"orgID": "0000000000000001",
+			"userID": "0000000000000002",
+			"user": "alice",
+			"permissions": [
+				{"action": "write", "resource": {"type": "buckets", "orgID": "0000000000000001"}},
+				{"action": "read", "resource": {"type": "buckets", "id": "0000000000000004", "orgID": "0000000000000001"}}
+			]
+		}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	d := schema.TestResourceDataRaw(t, dataSourceAuthorizations().Schema, map[string]interface{}{
+		"user_name": "alice",
+		"org_id":    "0000000000000001",
+	})
+	if diags := dataSourceAuthorizationsRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	auth := d.Get("authorizations").([]interface{})[0].(map[string]interface{})
+	if auth["status"] != "inactive" || auth["user_name"] != "alice" {
+		t.Errorf("unexpected authorization %v", auth)
+	}
+	if got := auth["permissions_summary"]; got != "write:buckets, read:buckets/0000000000000004" {
+		t.Errorf("unexpected permissions summary %q", got)
+	}
+	if _, ok := auth["token"]; ok {
+		t.Errorf("expected no token, got %v", auth)
+	}
+}
+
+func TestSummarizePermissions(t *testing.T) {
+	id := "0000000000000004"
+	got := summarizePermissions([]domain.Permission{
+		{Action: domain.PermissionActionWrite, Resource: domain.Resource{Type: domain.ResourceTypeTasks}},
+		{Action: domain.PermissionActionRead, Resource: domain.Resource{Type: domain.ResourceTypeBuckets, Id: &id}},
+	})
+	if got != "read:buckets/0000000000000004, write:tasks" {
+		t.Errorf("unexpected summary %q", got)
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBucketSchemas() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the measurement schemas of an InfluxDB Cloud bucket with an `explicit` schema type, including their columns, e.g. for ingestion pipelines to validate data against the declared schema.",
+
+		ReadContext: withDefaultOrgID(dataSourceBucketSchemasRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the bucket.",
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the bucket. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"schemas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The measurement schemas, sorted by measurement name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the measurement schema.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the measurement.",
+						},
+						"columns": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The columns of the measurement.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the column.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the column: `tag`, `field` or `timestamp`.",
+									},
+									"data_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Data type of a `field` column: `integer`, `float`, `boolean`, `string` or `unsigned`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the measurement schemas by measurement name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketSchemasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	bucketID := d.Get("bucket_id").(string)
+
+	log.Printf("[INFO] Reading the Measurement Schemas of bucket (%s)", bucketID)
+
+	all, err := api.findMeasurementSchemas(ctx, orgID, bucketID)
+	if err != nil {
+		// a missing bucket is a 404 too, which the probe of the resource tells apart
+		if unavailable := optionalEndpoints["influxdb2_measurement_schema"].unavailable(ctx, api, d); unavailable != nil {
+			return diag.FromErr(unavailable)
+		}
+		return diag.Errorf("unable to retrieve the Measurement Schemas of bucket (%s): %v", bucketID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	schemas := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n", bucketID)
+	for _, s := range all {
+		schemas = append(schemas, map[string]interface{}{
+			"id":      s.ID,
+			"name":    s.Name,
+			"columns": flattenMeasurementSchemaColumns(s.Columns),
+		})
+		ids[s.Name] = s.ID
+		fmt.Fprintf(hash, "%s\n", s.ID)
+	}
+
+	log.Printf("[INFO] Found %d Measurement Schemas in bucket (%s)", len(schemas), bucketID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("schemas", schemas); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
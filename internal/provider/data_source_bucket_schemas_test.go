@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Measurement schemas need an InfluxDB Cloud bucket with an explicit schema type, which the
+// docker-compose test server doesn't support, so the data source is tested against a fake
+// server.
+func TestDataSourceBucketSchemasRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/buckets/0000000000000002/schema/measurements" || r.URL.Query().Get("orgID") != "0000000000000001" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"measurementSchemas":[
+			{"id":"0000000000000004","name":"mem","columns":[{"name":"time","type":"timestamp"},{"name":"used","type":"field","dataType":"integer"}]},
+			{"id":"0000000000000003","name":"cpu","columns":[{"name":"time","type":"timestamp"},{"name":"host","type":"tag"},{"name":"usage","type":"field","dataType":"float"}]}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	d := schema.TestResourceDataRaw(t, dataSourceBucketSchemas().Schema, map[string]interface{}{
+		"org_id":    "0000000000000001",
+		"bucket_id": "0000000000000002",
+	})
+	if diags := dataSourceBucketSchemasRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"schemas.#":                     2,
+		"schemas.0.name":                "cpu",
+		"schemas.0.columns.#":           3,
+		"schemas.0.columns.1.type":      "tag",
+		"schemas.0.columns.2.data_type": "float",
+		"schemas.1.name":                "mem",
+		"ids.mem":                       "0000000000000004",
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("expected %s to be %v, got %v", k, v, got)
+		}
+	}
+}
+
+func TestDataSourceBucketSchemasReadUnavailable(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		bucketStatus int
+		expected     string
+	}{
+		{"API missing", http.StatusNotFound, http.StatusOK, "requires InfluxDB Cloud"},
+		{"API disabled", http.StatusForbidden, http.StatusOK, "measurement schemas API is forbidden"},
+		{"bucket missing", http.StatusNotFound, http.StatusNotFound, "unable to retrieve the Measurement Schemas"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v2/buckets/0000000000000002/schema/measurements":
+					w.WriteHeader(tt.status)
+				case "/api/v2/buckets/0000000000000002":
+					w.WriteHeader(tt.bucketStatus)
+				default:
+					t.Errorf("unexpected request %s", r.URL)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+				w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(srv.Close)
+
+			d := schema.TestResourceDataRaw(t, dataSourceBucketSchemas().Schema, map[string]interface{}{
+				"org_id":    "0000000000000001",
+				"bucket_id": "0000000000000002",
+			})
+			diags := dataSourceBucketSchemasRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, tt.expected) {
+				t.Fatalf("expected an error containing %q, got: %v", tt.expected, diags)
+			}
+		})
+	}
+}
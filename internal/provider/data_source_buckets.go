@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceBuckets() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the buckets of an Organization, optionally filtered by name prefix or ID, e.g. to attach a DBRP mapping or an authorization to every bucket with `for_each`. See `influxdb2_all_buckets` for the buckets of all Organizations.",
+
+		ReadContext: withDefaultOrgID(dataSourceBucketsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the buckets. Defaults to the `org_id` of the provider.",
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the buckets whose name starts with the prefix.",
+			},
+			"bucket_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only list the buckets with these IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_system": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to include the system buckets, e.g. `_monitoring`.",
+			},
+			// Computed outputs
+			"buckets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The buckets, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the bucket.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the bucket.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the bucket.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the bucket: `user` or `system`.",
+						},
+						"retention_period": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Duration in seconds for how long data is kept in the bucket, `0` for infinite retention.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the buckets by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the buckets of Organization (%s)", orgID)
+
+	all, err := api.findBuckets(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the buckets of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	namePrefix := d.Get("name_prefix").(string)
+	bucketIDs := d.Get("bucket_ids").(*schema.Set)
+	includeSystem := d.Get("include_system").(bool)
+
+	buckets := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, b := range all {
+		if b.Type != nil && *b.Type == domain.BucketTypeSystem && !includeSystem {
+			continue
+		}
+		if !strings.HasPrefix(b.Name, namePrefix) {
+			continue
+		}
+		if bucketIDs.Len() > 0 && !bucketIDs.Contains(stringValue(b.Id)) {
+			continue
+		}
+		buckets = append(buckets, map[string]interface{}{
+			"id":               stringValue(b.Id),
+			"name":             b.Name,
+			"description":      stringValue(b.Description),
+			"type":             bucketType(b),
+			"retention_period": bucketRetentionPeriod(b),
+		})
+		ids[b.Name] = stringValue(b.Id)
+		fmt.Fprintf(hash, "%s\n", stringValue(b.Id))
+	}
+
+	log.Printf("[INFO] Found %d buckets in Organization (%s)", len(buckets), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("buckets", buckets); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
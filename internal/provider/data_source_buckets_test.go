@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func influxBuckets(filters string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		data "influxdb2_buckets" "buckets" {
+			org_id = data.influxdb2_organization.initial.id
+			%s
+		}
+`, testInitialOrg, filters)
+}
+
+func TestAccDataSourceBuckets(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxBuckets(``)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_buckets.buckets", "buckets.#", "1"),
+					resource.TestCheckResourceAttr("data.influxdb2_buckets.buckets", "buckets.0.name", testInitialBucket),
+					resource.TestCheckResourceAttr("data.influxdb2_buckets.buckets", "ids."+testInitialBucket, bucketID),
+				),
+			},
+			{
+				Config: testConfig(influxBuckets(`include_system = true
+					name_prefix = "_"`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_buckets.buckets", "buckets.*", map[string]string{
+						"name": "_monitoring",
+						"type": "system",
+					}),
+					resource.TestCheckNoResourceAttr("data.influxdb2_buckets.buckets", "ids."+testInitialBucket),
+				),
+			},
+			{
+				Config: testConfig(influxBuckets(`bucket_ids = ["0000000000000000"]`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_buckets.buckets", "buckets.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCheck() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a deadman or threshold check by name or ID, e.g. to bind notification rules to a check created by a template.",
+
+		ReadContext: dataSourceCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+				Description:  "ID of the Organization that owns the check. Used to lookup the check by name, defaults to the `org_id` of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the check.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the check.",
+			},
+			// Computed outputs
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the check.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the check, `deadman` or `threshold`.",
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Flux query of the check.",
+			},
+			"every": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The interval the check runs at, e.g. `1m`.",
+			},
+			"offset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The delay of the runs of the check after their scheduled time.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the check, `active` or `inactive`.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the labels of the check.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_run_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last run: `success`, `failed` or `canceled`.",
+			},
+			"last_run_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error of the last run, when it failed.",
+			},
+			"latest_completed": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The scheduled time, as an RFC3339 timestamp, of the latest completed run.",
+			},
+		},
+	}
+}
+
+func dataSourceCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		ch  *check
+		err error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		orgID := d.Get("org_id").(string)
+		log.Printf("[INFO] Reading check with name (%s) of Organization (%s)", name, orgID)
+		if ch, err = api.findCheckByName(ctx, orgID, name); err != nil {
+			return diag.Errorf("unable to retrieve check with name (%s) of Organization (%s): %v", name, orgID, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading check (%s)", id)
+		if ch, err = api.findCheckByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve check (%s): %v", id, err)
+		}
+	}
+
+	if err := setCheckData(d, ch); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setCheckData(d *schema.ResourceData, ch *check) error {
+	latestCompleted := ""
+	if ch.LatestCompleted != nil {
+		latestCompleted = ch.LatestCompleted.UTC().Format(time.RFC3339)
+	}
+
+	d.SetId(ch.ID)
+	if err := d.Set("id", ch.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", ch.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", ch.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", ch.Description); err != nil {
+		return err
+	}
+	if err := d.Set("type", ch.Type); err != nil {
+		return err
+	}
+	if err := d.Set("query", ch.Query.Text); err != nil {
+		return err
+	}
+	if err := d.Set("every", ch.Every); err != nil {
+		return err
+	}
+	if err := d.Set("offset", ch.Offset); err != nil {
+		return err
+	}
+	if err := d.Set("status", ch.Status); err != nil {
+		return err
+	}
+	if err := d.Set("labels", ch.labelNames()); err != nil {
+		return err
+	}
+	if err := d.Set("last_run_status", ch.LastRunStatus); err != nil {
+		return err
+	}
+	if err := d.Set("last_run_error", ch.LastRunError); err != nil {
+		return err
+	}
+	if err := d.Set("latest_completed", latestCompleted); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCheckStatuses() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the latest statuses written by a check to the `_monitoring` bucket, e.g. to verify after a deployment that an alerting pipeline produces statuses.",
+
+		ReadContext: withDefaultOrgID(dataSourceCheckStatusesRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"check_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the check.",
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the check. Defaults to the `org_id` of the provider.",
+			},
+			"range_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "-1h",
+				ValidateDiagFunc: validateFluxNegativeDuration,
+				Description:      "How far back to look for statuses, as a negative Flux duration, e.g. `-15m` or `-1d`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: validateIntBetween(1, 1000),
+				Description:      "Maximum number of statuses to return.",
+			},
+			// Computed outputs
+			"statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The statuses, newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the status was written, in RFC3339 format.",
+						},
+						"level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The level of the status: `ok`, `info`, `warn`, `crit` or `unknown`.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status message.",
+						},
+					},
+				},
+			},
+			"latest_level": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The level of the latest status, or an empty string when the check has no status since `range_start`.",
+			},
+		},
+	}
+}
+
+func dataSourceCheckStatusesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	checkID := d.Get("check_id").(string)
+
+	log.Printf("[INFO] Reading the statuses of check (%s)", checkID)
+
+	rows, err := api.queryMonitoring(ctx, orgID, "statuses", "_check_id", checkID, d.Get("range_start").(string), d.Get("limit").(int))
+	if err != nil {
+		return diag.Errorf("unable to retrieve the statuses of check (%s) of Organization (%s): %v", checkID, orgID, err)
+	}
+
+	log.Printf("[INFO] Found %d statuses of check (%s)", len(rows), checkID)
+
+	statuses := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		statuses = append(statuses, map[string]interface{}{
+			"time":    row["_time"],
+			"level":   row["_level"],
+			"message": row["_message"],
+		})
+	}
+	latestLevel := ""
+	if len(rows) > 0 {
+		latestLevel = rows[0]["_level"]
+	}
+
+	d.SetId(checkID)
+	if err := d.Set("statuses", statuses); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_level", latestLevel); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
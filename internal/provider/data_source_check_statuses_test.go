@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Checks only write statuses once the task scheduler runs them, so the statuses are
+// tested against a fake server.
+func TestDataSourceCheckStatusesRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req queryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, `r._measurement == "statuses" and r["_check_id"] == "0000000000000002"`) {
+			t.Errorf("unexpected query %s", req.Query)
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte(strings.Join([]string{
+			"#datatype,string,long,dateTime:RFC3339,string,string,string,long",
+			"#group,false,false,false,false,false,false,false",
+			"#default,_result,,,,,,",
+			",result,table,_time,_check_id,_level,_message,_source_timestamp",
+			",,0,2021-06-01T10:01:00Z,0000000000000002,crit,Check: cpu is: crit,1622541660000000000",
+			",,0,2021-06-01T10:00:00Z,0000000000000002,ok,Check: cpu is: ok,1622541600000000000",
+			"",
+		}, "\r\n")))
+	}))
+	t.Cleanup(srv.Close)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCheckStatuses().Schema, map[string]interface{}{
+		"org_id":   "0000000000000001",
+		"check_id": "0000000000000002",
+	})
+	if diags := dataSourceCheckStatusesRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Get("statuses.#") != 2 || d.Get("latest_level") != "crit" || d.Get("statuses.0.time") != "2021-06-01T10:01:00Z" || d.Get("statuses.1.message") != "Check: cpu is: ok" {
+		t.Fatalf("unexpected statuses: %v", d.State())
+	}
+}
+
+func TestAccDataSourceCheckStatuses(t *testing.T) {
+	checkName := acctest.RandomWithPrefix("test-check")
+
+	orgID := testAccInitialOrgID(t)
+	checkID := testAccCheck(t, orgID, checkName)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// a new check hasn't run yet
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_check_statuses" "test" {
+						org_id   = data.influxdb2_organization.initial.id
+						check_id = "%s"
+					}
+				`, checkID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_check_statuses.test", "statuses.#", "0"),
+					resource.TestCheckResourceAttr("data.influxdb2_check_statuses.test", "latest_level", ""),
+				),
+			},
+		},
+	})
+}
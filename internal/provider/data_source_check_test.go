@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceCheck(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-check")
+
+	orgID := testAccInitialOrgID(t)
+	checkID := testAccCheck(t, orgID, name)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_check" "by_name" {
+						org_id = "%s"
+						name   = "%s"
+					}
+					data "influxdb2_check" "by_id" {
+						id = "%s"
+					}
+				`, orgID, name, checkID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_name", "id", checkID),
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_name", "type", "threshold"),
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_name", "every", "1m"),
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_name", "status", "active"),
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_id", "name", name),
+					resource.TestCheckResourceAttr("data.influxdb2_check.by_id", "org_id", orgID),
+				),
+			},
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_check" "missing" {
+						org_id = "%s"
+						name   = "missing-check"
+					}
+				`, orgID)),
+				ExpectError: regexp.MustCompile(`unable to retrieve check with name \(missing-check\)`),
+			},
+		},
+	})
+}
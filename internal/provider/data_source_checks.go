@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceChecks() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the checks of an Organization, optionally filtered by label, e.g. to produce an inventory of alerts and cross-reference it with the notification endpoints they reach.",
+
+		ReadContext: withDefaultOrgID(dataSourceChecksRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the checks. Defaults to the `org_id` of the provider.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only list the checks with all the labels of these names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The checks, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the check.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the check.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the check.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the check, `deadman` or `threshold`.",
+						},
+						"every": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The interval the check runs at, e.g. `1m`.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the check, `active` or `inactive`.",
+						},
+						"labels": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "The names of the labels of the check.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"last_run_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the last run: `success`, `failed` or `canceled`.",
+						},
+						"latest_completed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The scheduled time, as an RFC3339 timestamp, of the latest completed run.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the checks by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	var labels []string
+	for _, l := range d.Get("labels").(*schema.Set).List() {
+		labels = append(labels, l.(string))
+	}
+
+	log.Printf("[INFO] Reading the checks of Organization (%s) with labels %v", orgID, labels)
+
+	all, err := api.findChecks(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the checks of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	checks := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, ch := range all {
+		if !hasAllLabels(ch.Labels, labels) {
+			continue
+		}
+		latestCompleted := ""
+		if ch.LatestCompleted != nil {
+			latestCompleted = ch.LatestCompleted.UTC().Format(time.RFC3339)
+		}
+		checks = append(checks, map[string]interface{}{
+			"id":               ch.ID,
+			"name":             ch.Name,
+			"description":      ch.Description,
+			"type":             ch.Type,
+			"every":            ch.Every,
+			"status":           ch.Status,
+			"labels":           ch.labelNames(),
+			"last_run_status":  ch.LastRunStatus,
+			"latest_completed": latestCompleted,
+		})
+		ids[ch.Name] = ch.ID
+		fmt.Fprintf(hash, "%s\n", ch.ID)
+	}
+
+	log.Printf("[INFO] Found %d checks in Organization (%s)", len(checks), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("checks", checks); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceChecks(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-checks") + "-"
+
+	orgID := testAccInitialOrgID(t)
+	labelID := testAccLabel(t, orgID, prefix+"critical", "#DC4E58")
+	labelledID := testAccCheck(t, orgID, prefix+"labelled", labelID)
+	otherID := testAccCheck(t, orgID, prefix+"other")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_checks" "all" {
+						org_id = "%[1]s"
+					}
+					data "influxdb2_checks" "labelled" {
+						org_id = "%[1]s"
+						labels = ["%[2]s"]
+					}
+				`, orgID, prefix+"critical")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_checks.all", "ids."+prefix+"labelled", labelledID),
+					resource.TestCheckResourceAttr("data.influxdb2_checks.all", "ids."+prefix+"other", otherID),
+					resource.TestCheckResourceAttr("data.influxdb2_checks.labelled", "checks.#", "1"),
+					resource.TestCheckResourceAttr("data.influxdb2_checks.labelled", "checks.0.id", labelledID),
+					resource.TestCheckTypeSetElemAttr("data.influxdb2_checks.labelled", "checks.0.labels.*", prefix+"critical"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a Dashboard by name or ID with the layout of its cells, e.g. to attach labels to or grant access to a Dashboard created by a template.",
+
+		ReadContext: dataSourceDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+				Description:  "ID of the Organization that owns the Dashboard. Used to lookup the Dashboard by name, defaults to the `org_id` of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the Dashboard.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Dashboard.",
+			},
+			// Computed outputs
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the Dashboard.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the labels of the Dashboard.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cells": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cells of the Dashboard, top to bottom & left to right.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the cell.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the view of the cell.",
+						},
+						"view_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the view of the cell.",
+						},
+						"view_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the view of the cell, e.g. `xy` or `markdown`.",
+						},
+						"x": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The column of the cell.",
+						},
+						"y": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The row of the cell.",
+						},
+						"w": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The width of the cell, in columns.",
+						},
+						"h": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The height of the cell, in rows.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		dashboard *domain.DashboardWithViewProperties
+		err       error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		orgID := d.Get("org_id").(string)
+		log.Printf("[INFO] Reading Dashboard with name (%s) of Organization (%s)", name, orgID)
+		if dashboard, err = api.findDashboardByName(ctx, orgID, name); err != nil {
+			return diag.Errorf("unable to retrieve Dashboard with name (%s) of Organization (%s): %v", name, orgID, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading Dashboard (%s)", id)
+		if dashboard, err = api.findDashboardByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve Dashboard (%s): %v", id, err)
+		}
+	}
+
+	if err := setDashboardData(d, dashboard); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setDashboardData(d *schema.ResourceData, dashboard *domain.DashboardWithViewProperties) error {
+	var cells []domain.CellWithViewProperties
+	if dashboard.Cells != nil {
+		cells = *dashboard.Cells
+	}
+	// top to bottom & left to right, like the cells of the resource, see sortCells
+	sort.SliceStable(cells, func(i, j int) bool {
+		if int32Value(cells[i].Y) != int32Value(cells[j].Y) {
+			return int32Value(cells[i].Y) < int32Value(cells[j].Y)
+		}
+		return int32Value(cells[i].X) < int32Value(cells[j].X)
+	})
+
+	flatCells := make([]interface{}, 0, len(cells))
+	for _, cell := range cells {
+		viewType := ""
+		if cell.Properties != nil {
+			if properties, ok := (*cell.Properties).(map[string]interface{}); ok {
+				viewType, _ = properties["type"].(string)
+			}
+		}
+		flatCells = append(flatCells, map[string]interface{}{
+			"id":        stringValue(cell.Id),
+			"name":      stringValue(cell.Name),
+			"view_id":   stringValue(cell.ViewID),
+			"view_type": viewType,
+			"x":         int(int32Value(cell.X)),
+			"y":         int(int32Value(cell.Y)),
+			"w":         int(int32Value(cell.W)),
+			"h":         int(int32Value(cell.H)),
+		})
+	}
+	labels := []string{}
+	if dashboard.Labels != nil {
+		for _, l := range *dashboard.Labels {
+			labels = append(labels, stringValue(l.Name))
+		}
+	}
+
+	d.SetId(stringValue(dashboard.Id))
+	if err := d.Set("id", stringValue(dashboard.Id)); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", dashboard.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", dashboard.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", stringValue(dashboard.Description)); err != nil {
+		return err
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+	if err := d.Set("cells", flatCells); err != nil {
+		return err
+	}
+	return nil
+}
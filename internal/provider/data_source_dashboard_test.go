@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceDashboard(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxDashboard(org, "cpu") + `
+					data "influxdb2_dashboard" "by_name" {
+						org_id = influxdb2_organization.org.id
+						name   = influxdb2_dashboard.dashboard.name
+					}
+					data "influxdb2_dashboard" "by_id" {
+						id = influxdb2_dashboard.dashboard.id
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdb2_dashboard.by_name", "id", "influxdb2_dashboard.dashboard", "id"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_name", "description", "cpu usage"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_name", "cells.#", "2"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_name", "cells.0.name", "Notes"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_name", "cells.0.view_type", "markdown"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_name", "cells.1.x", "4"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboard.by_id", "name", "cpu"),
+				),
+			},
+			{
+				Config: testConfig(fmt.Sprintf(`
+					resource "influxdb2_organization" "org" {
+						name = "%s"
+					}
+					data "influxdb2_dashboard" "missing" {
+						org_id = influxdb2_organization.org.id
+						name   = "missing-dashboard"
+					}
+				`, org)),
+				ExpectError: regexp.MustCompile(`unable to retrieve Dashboard with name \(missing-dashboard\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceDashboards() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the Dashboards of an Organization, optionally filtered by label, e.g. for reports on all the Dashboards of an Organization.",
+
+		ReadContext: withDefaultOrgID(dataSourceDashboardsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the Dashboards. Defaults to the `org_id` of the provider.",
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only list the Dashboards with all the labels of these names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"dashboards": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Dashboards, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Dashboard.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Dashboard.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the Dashboard.",
+						},
+						"labels": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "The names of the labels of the Dashboard.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cell_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of cells of the Dashboard.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the Dashboards by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	var labels []string
+	for _, l := range d.Get("labels").(*schema.Set).List() {
+		labels = append(labels, l.(string))
+	}
+
+	log.Printf("[INFO] Reading the Dashboards of Organization (%s) with labels %v", orgID, labels)
+
+	all, err := api.findDashboards(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the Dashboards of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	dashboards := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, dashboard := range all {
+		var dashboardLabels []domain.Label
+		if dashboard.Labels != nil {
+			dashboardLabels = *dashboard.Labels
+		}
+		if !hasAllLabels(dashboardLabels, labels) {
+			continue
+		}
+		labelNames := make([]interface{}, 0, len(dashboardLabels))
+		for _, l := range dashboardLabels {
+			labelNames = append(labelNames, stringValue(l.Name))
+		}
+		cellCount := 0
+		if dashboard.Cells != nil {
+			cellCount = len(*dashboard.Cells)
+		}
+		id := stringValue(dashboard.Id)
+		dashboards = append(dashboards, map[string]interface{}{
+			"id":          id,
+			"name":        dashboard.Name,
+			"description": stringValue(dashboard.Description),
+			"labels":      labelNames,
+			"cell_count":  cellCount,
+		})
+		ids[dashboard.Name] = id
+		fmt.Fprintf(hash, "%s\n", id)
+	}
+
+	log.Printf("[INFO] Found %d Dashboards in Organization (%s)", len(dashboards), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("dashboards", dashboards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceDashboards(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxDashboard(org, "cpu") + `
+					data "influxdb2_dashboards" "all" {
+						org_id = influxdb2_organization.org.id
+
+						depends_on = [influxdb2_dashboard.dashboard]
+					}
+					data "influxdb2_dashboards" "labelled" {
+						org_id = influxdb2_organization.org.id
+						labels = ["missing-label"]
+
+						depends_on = [influxdb2_dashboard.dashboard]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_dashboards.all", "dashboards.#", "1"),
+					resource.TestCheckResourceAttrPair("data.influxdb2_dashboards.all", "ids.cpu", "influxdb2_dashboard.dashboard", "id"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboards.all", "dashboards.0.cell_count", "2"),
+					resource.TestCheckResourceAttr("data.influxdb2_dashboards.labelled", "dashboards.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabase() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a database of an InfluxDB Cloud Dedicated cluster, through the Management API configured by the `cloud_dedicated` provider block.",
+
+		ReadContext: dataSourceDatabaseRead,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the database.",
+			},
+			// Computed outputs
+			"retention_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Duration in seconds for how long data is kept in the database, `0` for infinite retention.",
+			},
+			"max_tables": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of tables of the database.",
+			},
+			"max_columns_per_table": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of columns per table of the database.",
+			},
+			"partition_template": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The parts of the partition template of the database, in order.",
+				Elem:        databasePartitionSchema(),
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Cloud Dedicated account.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Cloud Dedicated cluster.",
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading Database (%s)", name)
+
+	db, err := api.findDatabaseByName(ctx, name)
+	if err != nil {
+		return diag.Errorf("unable to retrieve Database (%s): %v", name, err)
+	}
+
+	d.SetId(db.Name)
+	if err := setDatabaseResourceData(d, db); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDBRP() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup the DBRP mapping of an InfluxDB 1.x database in InfluxDB2, to find the bucket that backs it.",
+
+		ReadContext: withDefaultOrgID(dataSourceDBRPRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The InfluxDB 1.x database name.",
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the mapping. Defaults to the `org_id` of the provider.",
+			},
+			"retention_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The InfluxDB 1.x retention policy name. When omitted, the default retention policy of the database is used.",
+			},
+			// Computed outputs
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the mapping.",
+			},
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the bucket that the database & retention policy map to.",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this mapping is the default retention policy for the database.",
+			},
+			"virtual": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this mapping was generated automatically by InfluxDB for a bucket.",
+			},
+		},
+	}
+}
+
+func dataSourceDBRPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	database := d.Get("database").(string)
+
+	filter := url.Values{"db": []string{database}}
+	rp, hasRP := d.GetOk("retention_policy")
+	if hasRP {
+		filter.Set("rp", rp.(string))
+	}
+
+	log.Printf("[INFO] Reading DBRPs of database (%s) in Organization (%s)", database, orgID)
+
+	mappings, err := api.findDBRPs(ctx, orgID, filter)
+	if err != nil {
+		return diag.Errorf("unable to retrieve DBRPs of database (%s) in Organization (%s): %v", database, orgID, err)
+	}
+
+	var mapping *dbrp
+	switch {
+	case len(mappings) == 1:
+		mapping = &mappings[0]
+	case len(mappings) > 1 && !hasRP:
+		for i := range mappings {
+			if mappings[i].Default {
+				mapping = &mappings[i]
+				break
+			}
+		}
+		if mapping == nil {
+			return diag.Errorf("database (%s) has %d DBRP mappings and none is the default; set retention_policy to pick one", database, len(mappings))
+		}
+	case len(mappings) > 1:
+		return diag.Errorf("database (%s) has %d DBRP mappings for retention policy (%s)", database, len(mappings), rp)
+	default:
+		if hasRP {
+			return diag.Errorf("Can't find DBRP mapping for database (%s) and retention policy (%s)", database, rp)
+		}
+		return diag.Errorf("Can't find DBRP mapping for database (%s)", database)
+	}
+
+	d.SetId(mapping.ID)
+	d.Set("bucket_id", mapping.BucketID)
+	d.Set("retention_policy", mapping.RetentionPolicy)
+	d.Set("default", mapping.Default)
+	d.Set("virtual", mapping.Virtual)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testDataSourceDBRPConfig(bucketID string, database string) string {
+	return influxDBRP(bucketID, database, "autogen", true) + `
+		data "influxdb2_dbrp" "by_database" {
+			org_id   = influxdb2_dbrp.dbrp.org_id
+			database = influxdb2_dbrp.dbrp.database
+		}
+		data "influxdb2_dbrp" "by_rp" {
+			org_id           = influxdb2_dbrp.dbrp.org_id
+			database         = influxdb2_dbrp.dbrp.database
+			retention_policy = influxdb2_dbrp.dbrp.retention_policy
+		}
+`
+}
+
+func TestAccDataSourceDBRP(t *testing.T) {
+	db := acctest.RandomWithPrefix("test-db")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testDataSourceDBRPConfig(bucketID, db)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_dbrp.by_database", "bucket_id", bucketID),
+					resource.TestCheckResourceAttr("data.influxdb2_dbrp.by_database", "retention_policy", "autogen"),
+					resource.TestCheckResourceAttrPair("data.influxdb2_dbrp.by_rp", "id", "influxdb2_dbrp.dbrp", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDBRPs() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the DBRP mappings of an Organization, optionally filtered by bucket or database, including the virtual mappings InfluxDB generates for every bucket, e.g. to audit the InfluxDB 1.x compatibility configuration before changing it.",
+
+		ReadContext: withDefaultOrgID(dataSourceDBRPsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the mappings. Defaults to the `org_id` of the provider.",
+			},
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the mappings to the bucket with this ID.",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the mappings of this InfluxDB 1.x database.",
+			},
+			// Computed outputs
+			"dbrps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mappings, sorted by database & retention policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the mapping.",
+						},
+						"database": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The InfluxDB 1.x database name.",
+						},
+						"retention_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The InfluxDB 1.x retention policy name.",
+						},
+						"bucket_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the bucket that the database & retention policy map to.",
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this mapping is the default retention policy for the database.",
+						},
+						"virtual": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this mapping was generated automatically by InfluxDB for a bucket.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBRPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	filter := url.Values{}
+	if v, ok := d.GetOk("bucket_id"); ok {
+		filter.Set("bucketID", v.(string))
+	}
+	if v, ok := d.GetOk("database"); ok {
+		filter.Set("db", v.(string))
+	}
+
+	log.Printf("[INFO] Reading DBRPs (%v) in Organization (%s)", filter, orgID)
+
+	mappings, err := api.findDBRPs(ctx, orgID, filter)
+	if err != nil {
+		return diag.Errorf("unable to retrieve DBRPs in Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		if mappings[i].Database != mappings[j].Database {
+			return mappings[i].Database < mappings[j].Database
+		}
+		return mappings[i].RetentionPolicy < mappings[j].RetentionPolicy
+	})
+
+	dbrps := make([]interface{}, 0, len(mappings))
+	hash := sha256.New()
+	for _, m := range mappings {
+		dbrps = append(dbrps, map[string]interface{}{
+			"id":               m.ID,
+			"database":         m.Database,
+			"retention_policy": m.RetentionPolicy,
+			"bucket_id":        m.BucketID,
+			"default":          m.Default,
+			"virtual":          m.Virtual,
+		})
+		// virtual mappings share the ID of their bucket, so the ID is hashed with the database
+		fmt.Fprintf(hash, "%s/%s\n", m.ID, m.Database)
+	}
+
+	log.Printf("[INFO] Found %d DBRPs in Organization (%s)", len(dbrps), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("dbrps", dbrps); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceDBRPs(t *testing.T) {
+	db := acctest.RandomWithPrefix("test-db")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxDBRP(bucketID, db, "autogen", true) + `
+					data "influxdb2_dbrps" "by_database" {
+						org_id   = influxdb2_dbrp.dbrp.org_id
+						database = influxdb2_dbrp.dbrp.database
+					}
+					data "influxdb2_dbrps" "by_bucket" {
+						org_id    = influxdb2_dbrp.dbrp.org_id
+						bucket_id = influxdb2_dbrp.dbrp.bucket_id
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_dbrps.by_database", "dbrps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.influxdb2_dbrps.by_database", "dbrps.0.id", "influxdb2_dbrp.dbrp", "id"),
+					resource.TestCheckResourceAttr("data.influxdb2_dbrps.by_database", "dbrps.0.virtual", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_dbrps.by_bucket", "dbrps.*", map[string]string{
+						"database":         db,
+						"retention_policy": "autogen",
+						"bucket_id":        bucketID,
+					}),
+				),
+			},
+		},
+	})
+}
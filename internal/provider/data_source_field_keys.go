@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fieldTypes maps the annotated CSV datatypes of field values to the InfluxDB field types.
+var fieldTypes = map[string]string{
+	"double":       "float",
+	"long":         "integer",
+	"unsignedLong": "uinteger",
+	"boolean":      "boolean",
+	"string":       "string",
+}
+
+func dataSourceFieldKeys() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the field keys, and their types, of a measurement in the data written to a bucket since `range_start`.",
+
+		ReadContext: withDefaultOrgID(dataSourceFieldKeysRead),
+
+		Schema: mergeSchemas(schemaExplorationInputs(), map[string]*schema.Schema{
+			// Required inputs
+			"measurement": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+				Description:      "Name of the measurement.",
+			},
+			// Computed outputs
+			"field_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The field keys, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"types": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of the field keys to their type: `float`, `integer`, `uinteger`, `boolean` or `string`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceFieldKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	bucket := d.Get("bucket").(string)
+	measurement := d.Get("measurement").(string)
+
+	log.Printf("[INFO] Reading the field keys of measurement (%s) in bucket (%s) of Organization (%s)", measurement, bucket, orgID)
+
+	// schema.fieldKeys doesn't return the types, which are only available as the datatype
+	// annotation of the _value column: query the last value of each field instead
+	raw, err := api.query(ctx, orgID, fmt.Sprintf(`from(bucket: %s)
+  |> range(start: %s)
+  |> filter(fn: (r) => r._measurement == %s)
+  |> keep(columns: ["_field", "_value"])
+  |> group(columns: ["_field"])
+  |> last()`, fluxString(bucket), d.Get("range_start").(string), fluxString(measurement)))
+	types := map[string]interface{}{}
+	if err == nil {
+		err = readAnnotatedCSV(raw, func(row map[string]string, datatypes map[string]string) error {
+			datatype := datatypes["_value"]
+			t, ok := fieldTypes[datatype]
+			if !ok {
+				return fmt.Errorf("unexpected type (%s) of field (%s)", datatype, row["_field"])
+			}
+			types[row["_field"]] = t
+			return nil
+		})
+	}
+	if err != nil {
+		return diag.Errorf("unable to retrieve the field keys of measurement (%s) in bucket (%s) of Organization (%s): %v", measurement, bucket, orgID, err)
+	}
+
+	keys := make([]string, 0, len(types))
+	for k := range types {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	log.Printf("[INFO] Found %d field keys in measurement (%s)", len(keys), measurement)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n", orgID, bucket, measurement)
+	for _, k := range keys {
+		fmt.Fprintf(hash, "%s\n%s\n", k, types[k])
+	}
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("field_keys", keys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("types", types); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
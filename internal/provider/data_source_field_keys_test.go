@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceFieldKeys(t *testing.T) {
+	measurement := acctest.RandomWithPrefix("test_measurement")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxSchemaData(bucketID, measurement) + fmt.Sprintf(`
+					data "influxdb2_field_keys" "test" {
+						org_id      = data.influxdb2_organization.initial.id
+						bucket      = "%s"
+						measurement = "%s"
+
+						depends_on = [influxdb2_write.schema]
+					}
+				`, testInitialBucket, measurement)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "field_keys.#", "4"),
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "field_keys.0", "cores"),
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "types.cores", "integer"),
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "types.online", "boolean"),
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "types.owner", "string"),
+					resource.TestCheckResourceAttr("data.influxdb2_field_keys.test", "types.usage", "float"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFlags() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Read the feature flags of the server, e.g. to only create resources that depend on an experimental feature when it is enabled.",
+
+		ReadContext: dataSourceFlagsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"flags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The feature flags, keyed by their name. Strings are kept as is; other values, e.g. booleans, are JSON encoded.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"flags_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The feature flags as a JSON object, to get typed values with `jsondecode()`.",
+			},
+		},
+	}
+}
+
+func dataSourceFlagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading the feature flags")
+
+	flags, err := api.flags(ctx)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the feature flags: %v", err)
+	}
+	if flags == nil {
+		flags = map[string]json.RawMessage{}
+	}
+	flagsJSON, err := json.Marshal(flags)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Found %d feature flags", len(flags))
+
+	d.SetId(api.host)
+	if err := d.Set("flags", flattenJSONMap(flags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("flags_json", string(flagsJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFluxQuery() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Run a Flux query and return its results, e.g. for data driven configuration such as a DBRP mapping for every measurement found. Meant for small result sets: the read fails when the query returns more than `max_rows` rows. Use `influxdb2_query_export` to write larger results to a file.",
+
+		ReadContext: withDefaultOrgID(dataSourceFluxQueryRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"query": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Flux query to run.",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization to run the query in. Defaults to the `org_id` of the provider.",
+			},
+			"max_rows": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Maximum number of rows the query may return.",
+			},
+			// Computed outputs
+			"rows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rows of all the tables of the results, as maps from column name to value. Values are strings, as returned by InfluxDB2, e.g. `42` or `2021-01-01T00:00:00Z`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"row_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rows returned by the query.",
+			},
+			"csv": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The raw results, as annotated CSV.",
+			},
+		},
+	}
+}
+
+func dataSourceFluxQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	maxRows := d.Get("max_rows").(int)
+
+	log.Printf("[INFO] Running query in Organization (%s)", orgID)
+
+	raw, err := api.query(ctx, orgID, d.Get("query").(string))
+	if err != nil {
+		return diag.Errorf("unable to run query in Organization (%s): %v", orgID, err)
+	}
+	parsed, err := parseAnnotatedCSV(raw)
+	if err != nil {
+		return diag.Errorf("unable to parse query results: %v", err)
+	}
+	if len(parsed) > maxRows {
+		return diag.Errorf("query returned %d rows, more than max_rows (%d)", len(parsed), maxRows)
+	}
+
+	rows := make([]interface{}, 0, len(parsed))
+	for _, row := range parsed {
+		rows = append(rows, row)
+	}
+
+	log.Printf("[INFO] Query returned %d rows", len(rows))
+
+	sum := sha256.Sum256([]byte(raw))
+
+	d.SetId(hex.EncodeToString(sum[:]))
+	if err := d.Set("rows", rows); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("row_count", len(rows)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("csv", raw); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestQueryResponseSize(t *testing.T) {
+	const raw = "#datatype,string,long,long\n#group,false,false,false\n#default,_result,,\n,result,table,_value\n,,0,850\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte(raw))
+	}))
+	defer srv.Close()
+	api := newAPIClient(srv.URL, "token")
+
+	defer func(max int64) { maxQueryResponseSize = max }(maxQueryResponseSize)
+	maxQueryResponseSize = int64(len(raw))
+	if got, err := api.query(context.Background(), "0000000000000001", "buckets()"); err != nil || got != raw {
+		t.Fatalf("expected the response within the limit, got %q and err: %v", got, err)
+	}
+
+	maxQueryResponseSize = int64(len(raw)) - 1
+	if _, err := api.query(context.Background(), "0000000000000001", "buckets()"); err == nil || !strings.Contains(err.Error(), "the query response exceeds") {
+		t.Fatalf("expected the response to exceed the limit, got: %v", err)
+	}
+}
+
+func TestAccDataSourceFluxQuery(t *testing.T) {
+	orgID := testAccInitialOrgID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_flux_query" "buckets" {
+						org_id = "%s"
+						query  = <<EOT
+buckets()
+  |> filter(fn: (r) => r.name == "%s")
+  |> keep(columns: ["name", "retentionPeriod"])
+EOT
+					}
+				`, orgID, testInitialBucket)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_flux_query.buckets", "row_count", "1"),
+					resource.TestCheckResourceAttr("data.influxdb2_flux_query.buckets", "rows.0.name", testInitialBucket),
+					resource.TestCheckResourceAttrSet("data.influxdb2_flux_query.buckets", "rows.0.table"),
+					resource.TestMatchResourceAttr("data.influxdb2_flux_query.buckets", "csv", regexp.MustCompile(`#datatype`)),
+				),
+			},
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_flux_query" "too_many" {
+						org_id   = "%s"
+						query    = "buckets()"
+						max_rows = 1
+					}
+				`, orgID)),
+				ExpectError: regexp.MustCompile(`more than max_rows \(1\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceHealth() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Check the health of the InfluxDB2 server, so a configuration pointing at an unreachable or degraded server fails early with a clear message.",
+
+		ReadContext: dataSourceHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether an unreachable or unhealthy server fails the read. When `false`, the result is only reported by `status` & `message`.",
+			},
+			// Computed outputs
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the server, e.g. `influxdb`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of the server, `pass` or `fail`.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the server is unhealthy or unreachable, or the message of a healthy server.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the server, e.g. `2.0.4`.",
+			},
+			"commit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The commit the server was built from.",
+			},
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of the components of the server, when reported.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the component.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the component, `pass` or `fail`.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message of the component.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	failOnError := d.Get("fail_on_error").(bool)
+
+	log.Printf("[INFO] Reading the health of the server (%s)", api.host)
+
+	check, err := api.health(ctx)
+	if err != nil {
+		if failOnError {
+			return diag.Errorf("unable to reach InfluxDB2 server (%s): %v", api.host, err)
+		}
+		log.Printf("[WARN] unable to reach InfluxDB2 server (%s): %v", api.host, err)
+		msg := err.Error()
+		check = &domain.HealthCheck{Status: domain.HealthCheckStatusFail, Message: &msg}
+	}
+	if check.Status != domain.HealthCheckStatusPass && failOnError {
+		return diag.Errorf("InfluxDB2 server (%s) is not healthy: %s", api.host, stringValue(check.Message))
+	}
+
+	checks := []interface{}{}
+	if check.Checks != nil {
+		for _, c := range *check.Checks {
+			checks = append(checks, map[string]interface{}{
+				"name":    c.Name,
+				"status":  string(c.Status),
+				"message": stringValue(c.Message),
+			})
+		}
+	}
+
+	d.SetId(api.host)
+	if err := d.Set("name", check.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", string(check.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", stringValue(check.Message)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", stringValue(check.Version)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("commit", stringValue(check.Commit)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("checks", checks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
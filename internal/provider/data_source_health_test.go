@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A failing server can't be started with docker-compose, so the data source is tested
+// against a fake server.
+func TestDataSourceHealthRead(t *testing.T) {
+	status := http.StatusOK
+	body := `{"name":"influxdb","message":"ready for queries and writes","status":"pass","checks":[],"version":"2.0.4","commit":"4e7a59bb9a"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	meta := &metaData{api: newAPIClient(srv.URL, "token")}
+
+	read := func(t *testing.T, failOnError bool) (*schema.ResourceData, bool) {
+		d := schema.TestResourceDataRaw(t, dataSourceHealth().Schema, map[string]interface{}{
+			"fail_on_error": failOnError,
+		})
+		diags := dataSourceHealthRead(context.Background(), d, meta)
+		return d, diags.HasError()
+	}
+
+	d, failed := read(t, true)
+	if failed || d.Get("status") != "pass" || d.Get("version") != "2.0.4" || d.Get("commit") != "4e7a59bb9a" {
+		t.Fatalf("expected a healthy server, got %v", d.State())
+	}
+
+	status = http.StatusServiceUnavailable
+	body = `{"name":"influxdb","message":"not ready","status":"fail","checks":[{"name":"storage","status":"fail","message":"disk full"}]}`
+	if _, failed := read(t, true); !failed {
+		t.Fatal("expected an unhealthy server to fail the read")
+	}
+	d, failed = read(t, false)
+	if failed || d.Get("status") != "fail" || d.Get("message") != "not ready" || d.Get("checks.0.message") != "disk full" {
+		t.Fatalf("expected an unhealthy server to be reported, got %v", d.State())
+	}
+
+	srv.Close()
+	d, failed = read(t, false)
+	if failed || d.Get("status") != "fail" || d.Get("message") == "" {
+		t.Fatalf("expected an unreachable server to be reported, got %v", d.State())
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceLabel() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a label of an Organization by name or ID, e.g. a label created by a template or the UI.",
+
+		ReadContext: dataSourceLabelRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+				Description:  "ID of the Organization that owns the label. Used to lookup the label by name, defaults to the `org_id` of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the label.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the label.",
+			},
+			// Computed outputs
+			"color": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The color of the label, e.g. `#326BBA`.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the label.",
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All the properties of the label, including `color` & `description`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		label *domain.Label
+		err   error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		orgID := d.Get("org_id").(string)
+		log.Printf("[INFO] Reading label with name (%s) of Organization (%s)", name, orgID)
+		if label, err = api.findLabelByName(ctx, orgID, name); err != nil {
+			return diag.Errorf("unable to retrieve label with name (%s) of Organization (%s): %v", name, orgID, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading label (%s)", id)
+		if label, err = api.findLabelByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve label (%s): %v", id, err)
+		}
+	}
+
+	if err := setLabelData(d, label); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setLabelData(d *schema.ResourceData, label *domain.Label) error {
+	properties := map[string]interface{}{}
+	if label.Properties != nil {
+		for k, v := range label.Properties.AdditionalProperties {
+			properties[k] = v
+		}
+	}
+
+	d.SetId(stringValue(label.Id))
+	if err := d.Set("id", stringValue(label.Id)); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", stringValue(label.OrgID)); err != nil {
+		return err
+	}
+	if err := d.Set("name", stringValue(label.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("color", labelProperty(*label, "color")); err != nil {
+		return err
+	}
+	if err := d.Set("description", labelProperty(*label, "description")); err != nil {
+		return err
+	}
+	if err := d.Set("properties", properties); err != nil {
+		return err
+	}
+	return nil
+}
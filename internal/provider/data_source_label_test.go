@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceLabel(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-label")
+
+	orgID := testAccInitialOrgID(t)
+	labelID := testAccLabel(t, orgID, name, "#326BBA")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_label" "by_name" {
+						org_id = "%s"
+						name   = "%s"
+					}
+					data "influxdb2_label" "by_id" {
+						id = "%s"
+					}
+				`, orgID, name, labelID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_label.by_name", "id", labelID),
+					resource.TestCheckResourceAttr("data.influxdb2_label.by_name", "color", "#326BBA"),
+					resource.TestCheckResourceAttr("data.influxdb2_label.by_id", "name", name),
+					resource.TestCheckResourceAttr("data.influxdb2_label.by_id", "org_id", orgID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLabels() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the labels of an Organization, e.g. to attach labels in bulk with `for_each`.",
+
+		ReadContext: withDefaultOrgID(dataSourceLabelsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the labels. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"labels": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The labels, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the label.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the label.",
+						},
+						"color": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The color of the label, e.g. `#326BBA`.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the label.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the labels by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the labels of Organization (%s)", orgID)
+
+	all, err := api.findLabels(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the labels of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return stringValue(all[i].Name) < stringValue(all[j].Name) })
+
+	labels := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, l := range all {
+		labels = append(labels, map[string]interface{}{
+			"id":          stringValue(l.Id),
+			"name":        stringValue(l.Name),
+			"color":       labelProperty(l, "color"),
+			"description": labelProperty(l, "description"),
+		})
+		ids[stringValue(l.Name)] = stringValue(l.Id)
+		fmt.Fprintf(hash, "%s\n", stringValue(l.Id))
+	}
+
+	log.Printf("[INFO] Found %d labels in Organization (%s)", len(labels), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("labels", labels); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceLabels(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-labels") + "-"
+
+	orgID := testAccInitialOrgID(t)
+	prodID := testAccLabel(t, orgID, prefix+"prod", "#DC4E58")
+	testAccLabel(t, orgID, prefix+"dev", "#326BBA")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_labels" "labels" {
+						org_id = "%s"
+					}
+				`, orgID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_labels.labels", "labels.*", map[string]string{
+						"name":  prefix + "dev",
+						"color": "#326BBA",
+					}),
+					resource.TestCheckResourceAttr("data.influxdb2_labels.labels", "ids."+prefix+"prod", prodID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMe() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup the user that owns the token the provider is configured with, e.g. to make the identity applying the configuration an owner of the resources it creates.",
+
+		ReadContext: dataSourceMeRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the user.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the user, `active` or `inactive`.",
+			},
+			"oauth_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OAuth ID of the user, if any.",
+			},
+		},
+	}
+}
+
+func dataSourceMeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading the current user")
+
+	u, err := api.findMe(ctx)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the current user: %v", err)
+	}
+	if u.Id == nil {
+		return diag.Errorf("current user not found")
+	}
+
+	d.SetId(*u.Id)
+	if err := d.Set("id", *u.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", u.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", userStatus(*u)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", stringValue(u.OauthID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
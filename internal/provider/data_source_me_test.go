@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceMe(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					data "influxdb2_me" "test" {}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					// the user created by the docker-compose.yaml setup
+					resource.TestCheckResourceAttr("data.influxdb2_me.test", "name", "admin"),
+					resource.TestCheckResourceAttr("data.influxdb2_me.test", "status", "active"),
+					resource.TestMatchResourceAttr("data.influxdb2_me.test", "id", regexp.MustCompile(`^[0-9a-f]{16}$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMeasurements() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the measurements of the data written to a bucket since `range_start`, e.g. to generate a check or a DBRP mapping per measurement.",
+
+		ReadContext: withDefaultOrgID(dataSourceMeasurementsRead),
+
+		Schema: mergeSchemas(schemaExplorationInputs(), map[string]*schema.Schema{
+			// Computed outputs
+			"measurements": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The measurements, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMeasurementsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	log.Printf("[INFO] Reading the measurements of bucket (%s) in Organization (%s)", bucket, orgID)
+
+	measurements, err := api.querySchemaValues(ctx, orgID, fmt.Sprintf("schema.measurements(bucket: %s, start: %s)", fluxString(bucket), d.Get("range_start").(string)))
+	if err != nil {
+		return diag.Errorf("unable to retrieve the measurements of bucket (%s) in Organization (%s): %v", bucket, orgID, err)
+	}
+
+	log.Printf("[INFO] Found %d measurements in bucket (%s)", len(measurements), bucket)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n", orgID, bucket)
+	for _, m := range measurements {
+		fmt.Fprintf(hash, "%s\n", m)
+	}
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("measurements", measurements); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
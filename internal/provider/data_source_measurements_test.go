@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// influxSchemaData writes points of a measurement to the initial bucket for the schema
+// exploration data sources to find.
+func influxSchemaData(bucketID string, measurement string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_write" "schema" {
+			org_id        = data.influxdb2_organization.initial.id
+			bucket_id     = "%s"
+			line_protocol = <<EOT
+%[3]s,host=a,region=eu usage=1.5,cores=4i,online=true,owner="infra"
+%[3]s,host=b,region=us usage=2.5,cores=8i,online=false,owner="infra"
+EOT
+		}
+`, testInitialOrg, bucketID, measurement)
+}
+
+func TestAccDataSourceMeasurements(t *testing.T) {
+	measurement := acctest.RandomWithPrefix("test_measurement")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxSchemaData(bucketID, measurement) + fmt.Sprintf(`
+					data "influxdb2_measurements" "initial" {
+						org_id = data.influxdb2_organization.initial.id
+						bucket = "%s"
+
+						depends_on = [influxdb2_write.schema]
+					}
+				`, testInitialBucket)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.influxdb2_measurements.initial", "measurements.*", measurement),
+				),
+			},
+			{
+				Config: testConfig(`
+					data "influxdb2_measurements" "invalid" {
+						org_id      = "0000000000000001"
+						bucket      = "initial-bucket"
+						range_start = "30d"
+					}
+				`),
+				ExpectError: regexp.MustCompile(`must be a negative Flux duration`),
+			},
+		},
+	})
+}
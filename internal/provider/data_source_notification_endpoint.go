@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationEndpoint() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a notification endpoint by name or ID, e.g. to bind notification rules to an endpoint owned by another workspace. Credentials of the endpoint are never returned.",
+
+		ReadContext: dataSourceNotificationEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+				Description:  "ID of the Organization that owns the notification endpoint. Used to lookup the notification endpoint by name, defaults to the `org_id` of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the notification endpoint.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the notification endpoint.",
+			},
+			// Computed outputs
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the notification endpoint.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the notification endpoint: `slack`, `pagerduty`, `http` or `telegram`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the notification endpoint, `active` or `inactive`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL notifications are sent to, for `slack` & `http` endpoints.",
+			},
+		},
+	}
+}
+
+func dataSourceNotificationEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		e   *notificationEndpoint
+		err error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		orgID := d.Get("org_id").(string)
+		log.Printf("[INFO] Reading notification endpoint with name (%s) of Organization (%s)", name, orgID)
+		if e, err = api.findNotificationEndpointByName(ctx, orgID, name); err != nil {
+			return diag.Errorf("unable to retrieve notification endpoint with name (%s) of Organization (%s): %v", name, orgID, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading notification endpoint (%s)", id)
+		if e, err = api.findNotificationEndpointByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve notification endpoint (%s): %v", id, err)
+		}
+	}
+
+	if err := setNotificationEndpointData(d, e); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setNotificationEndpointData(d *schema.ResourceData, e *notificationEndpoint) error {
+	d.SetId(e.ID)
+	if err := d.Set("id", e.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", e.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", e.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", e.Description); err != nil {
+		return err
+	}
+	if err := d.Set("type", e.Type); err != nil {
+		return err
+	}
+	if err := d.Set("status", e.Status); err != nil {
+		return err
+	}
+	if err := d.Set("url", e.URL); err != nil {
+		return err
+	}
+	return nil
+}
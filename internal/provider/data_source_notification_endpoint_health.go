@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationTestClient sends test notifications. It is separate from the API client so the
+// InfluxDB2 token is never sent to a third party.
+var notificationTestClient = &http.Client{Timeout: 10 * time.Second}
+
+func dataSourceNotificationEndpointHealth() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Send a test notification through an existing Slack or HTTP notification endpoint and report whether it was delivered, so a broken alert channel is caught during apply instead of during the next incident. The notification is sent by the provider, not by InfluxDB2, so the endpoint must be reachable from where Terraform runs. Endpoints that need credentials (a Slack token or HTTP basic/bearer authentication) can't be tested, since the API never returns them.",
+
+		ReadContext: dataSourceNotificationEndpointHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"endpoint_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the notification endpoint to test.",
+			},
+			// Optional inputs
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Test notification sent by Terraform",
+				Description: "The message of the test notification.",
+			},
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether a failed delivery fails the read. When `false`, the result is only reported by `success` & `error`.",
+			},
+			// Computed outputs
+			"success": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the endpoint accepted the test notification.",
+			},
+			"status_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The HTTP status code returned by the endpoint, `0` if no response was received.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the delivery failed, empty on success.",
+			},
+		},
+	}
+}
+
+func dataSourceNotificationEndpointHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Get("endpoint_id").(string)
+
+	log.Printf("[INFO] Reading Notification Endpoint (%s)", id)
+
+	endpoint, err := api.findNotificationEndpointByID(ctx, id)
+	if err != nil {
+		return diag.Errorf("unable to retrieve Notification Endpoint (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Sending test notification through Notification Endpoint (%s)", id)
+
+	statusCode, err := sendTestNotification(ctx, endpoint, d.Get("message").(string))
+	if err != nil && d.Get("fail_on_error").(bool) {
+		return diag.Errorf("test notification through Notification Endpoint (%s) failed: %v", id, err)
+	}
+
+	errMsg := ""
+	if err != nil {
+		log.Printf("[WARN] test notification through Notification Endpoint (%s) failed: %v", id, err)
+		errMsg = err.Error()
+	}
+
+	d.SetId(id)
+	if err := d.Set("success", errMsg == ""); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status_code", statusCode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("error", errMsg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// sendTestNotification posts a message to a Slack incoming webhook or an HTTP endpoint, and
+// returns the status code of the response.
+func sendTestNotification(ctx context.Context, endpoint *notificationEndpoint, message string) (int, error) {
+	method := http.MethodPost
+	var payload interface{}
+
+	switch endpoint.Type {
+	case "slack":
+		payload = map[string]string{"text": message}
+	case "http":
+		if endpoint.AuthMethod != "" && endpoint.AuthMethod != "none" {
+			return 0, fmt.Errorf("%s authentication credentials aren't returned by the API, so the endpoint can't be tested", endpoint.AuthMethod)
+		}
+		if endpoint.Method != "" {
+			method = endpoint.Method
+		}
+		payload = map[string]string{
+			"_check_name":                 "Terraform test notification",
+			"_level":                      "ok",
+			"_message":                    message,
+			"_notification_endpoint_name": endpoint.Name,
+		}
+	default:
+		return 0, fmt.Errorf("testing %s endpoints isn't supported, only slack & http", endpoint.Type)
+	}
+	if endpoint.URL == "" {
+		return 0, fmt.Errorf("the endpoint has no URL")
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.URL, bytes.NewReader(b))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range endpoint.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := notificationTestClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("endpoint answered with %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Notification endpoints can't be created by the provider yet, so the data source is tested
+// against fake InfluxDB2 & webhook servers.
+func TestDataSourceNotificationEndpointHealth(t *testing.T) {
+	var received map[string]string
+	var receivedAuth string
+	webhookStatus := http.StatusOK
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedAuth = r.Header.Get("Authorization")
+		received = nil
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(webhookStatus)
+	}))
+	t.Cleanup(webhook.Close)
+
+	endpoints := map[string]string{
+		"0000000000000001": fmt.Sprintf(`{"id":"0000000000000001","type":"slack","url":"%s"}`, webhook.URL),
+		"0000000000000002": fmt.Sprintf(`{"id":"0000000000000002","type":"http","method":"POST","authMethod":"none","url":"%s"}`, webhook.URL),
+		"0000000000000003": fmt.Sprintf(`{"id":"0000000000000003","type":"http","method":"POST","authMethod":"bearer","url":"%s"}`, webhook.URL),
+		"0000000000000004": `{"id":"0000000000000004","type":"pagerduty","clientURL":"https://example.com"}`,
+	}
+	influx := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, ok := endpoints[r.URL.Path[len("/api/v2/notificationEndpoints/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not found","message":"notification endpoint not found"}`))
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(influx.Close)
+	meta := &metaData{api: newAPIClient(influx.URL, "secret-influx-token")}
+
+	read := func(t *testing.T, id string, failOnError bool) (*schema.ResourceData, bool) {
+		d := schema.TestResourceDataRaw(t, dataSourceNotificationEndpointHealth().Schema, map[string]interface{}{
+			"endpoint_id":   id,
+			"message":       "hello",
+			"fail_on_error": failOnError,
+		})
+		diags := dataSourceNotificationEndpointHealthRead(context.Background(), d, meta)
+		return d, diags.HasError()
+	}
+
+	t.Run("slack", func(t *testing.T) {
+		d, failed := read(t, "0000000000000001", true)
+		if failed || !d.Get("success").(bool) || received["text"] != "hello" {
+			t.Fatalf("expected a delivered slack message, got %v", received)
+		}
+		if receivedAuth != "" {
+			t.Fatal("the InfluxDB2 token must not be sent to the endpoint")
+		}
+	})
+
+	t.Run("http", func(t *testing.T) {
+		d, failed := read(t, "0000000000000002", true)
+		if failed || !d.Get("success").(bool) || received["_message"] != "hello" {
+			t.Fatalf("expected a delivered http message, got %v", received)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		webhookStatus = http.StatusGone
+		defer func() { webhookStatus = http.StatusOK }()
+
+		if _, failed := read(t, "0000000000000001", true); !failed {
+			t.Fatal("expected a failed delivery to fail the read")
+		}
+		d, failed := read(t, "0000000000000001", false)
+		if failed || d.Get("success").(bool) || d.Get("status_code").(int) != http.StatusGone || d.Get("error").(string) == "" {
+			t.Fatal("expected the failure to be reported without failing the read")
+		}
+	})
+
+	for _, id := range []string{"0000000000000003", "0000000000000004", "0000000000000005"} {
+		if _, failed := read(t, id, true); !failed {
+			t.Errorf("expected endpoint %s to fail the read", id)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNotificationEndpoint(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-endpoint")
+
+	orgID := testAccInitialOrgID(t)
+	endpointID := testAccNotificationEndpoint(t, orgID, name)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_notification_endpoint" "by_name" {
+						org_id = "%s"
+						name   = "%s"
+					}
+					data "influxdb2_notification_endpoint" "by_id" {
+						id = "%s"
+					}
+				`, orgID, name, endpointID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_name", "id", endpointID),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_name", "type", "http"),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_name", "status", "active"),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_name", "url", "http://localhost:8080/alerts"),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_id", "name", name),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoint.by_id", "org_id", orgID),
+				),
+			},
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_notification_endpoint" "missing" {
+						org_id = "%s"
+						name   = "missing-endpoint"
+					}
+				`, orgID)),
+				ExpectError: regexp.MustCompile(`unable to retrieve notification endpoint with name \(missing-endpoint\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationEndpoints() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the notification endpoints of an Organization, e.g. to attach notification rules to endpoints managed outside the current workspace. Credentials of the endpoints are never returned.",
+
+		ReadContext: withDefaultOrgID(dataSourceNotificationEndpointsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the notification endpoints. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"notification_endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The notification endpoints, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the notification endpoint.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the notification endpoint.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the notification endpoint.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the notification endpoint: `slack`, `pagerduty`, `http` or `telegram`.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the notification endpoint, `active` or `inactive`.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the notification endpoints by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNotificationEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the Notification Endpoints of Organization (%s)", orgID)
+
+	all, err := api.findNotificationEndpoints(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the Notification Endpoints of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	endpoints := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, e := range all {
+		endpoints = append(endpoints, map[string]interface{}{
+			"id":          e.ID,
+			"name":        e.Name,
+			"description": e.Description,
+			"type":        e.Type,
+			"status":      e.Status,
+		})
+		ids[e.Name] = e.ID
+		fmt.Fprintf(hash, "%s\n", e.ID)
+	}
+
+	log.Printf("[INFO] Found %d Notification Endpoints in Organization (%s)", len(endpoints), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("notification_endpoints", endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
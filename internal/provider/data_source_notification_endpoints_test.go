@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNotificationEndpoints(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-endpoint")
+
+	orgID := testAccInitialOrgID(t)
+	endpointID := testAccNotificationEndpoint(t, orgID, name)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_notification_endpoints" "all" {
+						org_id = "%s"
+					}
+				`, orgID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_notification_endpoints.all", "ids."+name, endpointID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_notification_endpoints.all", "notification_endpoints.*", map[string]string{
+						"id":     endpointID,
+						"type":   "http",
+						"status": "active",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationHistory() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the latest notifications sent by a notification rule, from the `_monitoring` bucket, e.g. to confirm in a smoke test that an alerting stack actually notifies.",
+
+		ReadContext: withDefaultOrgID(dataSourceNotificationHistoryRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"rule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the notification rule.",
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the notification rule. Defaults to the `org_id` of the provider.",
+			},
+			"range_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "-1h",
+				ValidateDiagFunc: validateFluxNegativeDuration,
+				Description:      "How far back to look for notifications, as a negative Flux duration, e.g. `-15m` or `-1d`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: validateIntBetween(1, 1000),
+				Description:      "Maximum number of notifications to return.",
+			},
+			// Computed outputs
+			"notifications": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The notifications, newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the notification was sent, in RFC3339 format.",
+						},
+						"level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The level of the status that was notified.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The notification message.",
+						},
+						"sent": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the endpoint accepted the notification.",
+						},
+						"check_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the check the status comes from.",
+						},
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the notification endpoint.",
+						},
+					},
+				},
+			},
+			"sent_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of the notifications returned that the endpoint accepted.",
+			},
+		},
+	}
+}
+
+func dataSourceNotificationHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	ruleID := d.Get("rule_id").(string)
+
+	log.Printf("[INFO] Reading the notifications of notification rule (%s)", ruleID)
+
+	rows, err := api.queryMonitoring(ctx, orgID, "notifications", "_notification_rule_id", ruleID, d.Get("range_start").(string), d.Get("limit").(int))
+	if err != nil {
+		return diag.Errorf("unable to retrieve the notifications of notification rule (%s) of Organization (%s): %v", ruleID, orgID, err)
+	}
+
+	log.Printf("[INFO] Found %d notifications of notification rule (%s)", len(rows), ruleID)
+
+	notifications := make([]map[string]interface{}, 0, len(rows))
+	sentCount := 0
+	for _, row := range rows {
+		sent := row["_sent"] == "true"
+		if sent {
+			sentCount++
+		}
+		notifications = append(notifications, map[string]interface{}{
+			"time":        row["_time"],
+			"level":       row["_level"],
+			"message":     row["_message"],
+			"sent":        sent,
+			"check_id":    row["_check_id"],
+			"endpoint_id": row["_notification_endpoint_id"],
+		})
+	}
+
+	d.SetId(ruleID)
+	if err := d.Set("notifications", notifications); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sent_count", sentCount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
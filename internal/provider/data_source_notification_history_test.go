@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Notification rules only notify once the task scheduler runs them, so the notifications
+// are tested against a fake server.
+func TestDataSourceNotificationHistoryRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req queryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, `r._measurement == "notifications" and r["_notification_rule_id"] == "0000000000000003"`) {
+			t.Errorf("unexpected query %s", req.Query)
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte(strings.Join([]string{
+			"#datatype,string,long,dateTime:RFC3339,string,string,string,string,string",
+			"#group,false,false,false,false,false,false,false,false",
+			"#default,_result,,,,,,,",
+			",result,table,_time,_check_id,_level,_message,_notification_endpoint_id,_sent",
+			",,0,2021-06-01T10:02:00Z,0000000000000002,crit,cpu is crit,0000000000000004,true",
+			",,0,2021-06-01T10:01:00Z,0000000000000002,crit,cpu is crit,0000000000000004,false",
+			"",
+		}, "\r\n")))
+	}))
+	t.Cleanup(srv.Close)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNotificationHistory().Schema, map[string]interface{}{
+		"org_id":  "0000000000000001",
+		"rule_id": "0000000000000003",
+	})
+	if diags := dataSourceNotificationHistoryRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Get("notifications.#") != 2 || d.Get("sent_count") != 1 || d.Get("notifications.0.sent") != true || d.Get("notifications.1.sent") != false || d.Get("notifications.0.endpoint_id") != "0000000000000004" {
+		t.Fatalf("unexpected notifications: %v", d.State())
+	}
+}
+
+func TestAccDataSourceNotificationHistory(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-notification")
+
+	orgID := testAccInitialOrgID(t)
+	endpointID := testAccNotificationEndpoint(t, orgID, prefix+"-endpoint")
+	ruleID := testAccNotificationRule(t, orgID, prefix+"-rule", endpointID, nil)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// a new rule hasn't run yet
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_notification_history" "test" {
+						org_id  = data.influxdb2_organization.initial.id
+						rule_id = "%s"
+					}
+				`, ruleID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_notification_history.test", "notifications.#", "0"),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_history.test", "sent_count", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationRules() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the notification rules of an Organization, optionally only those matching a check or tags, e.g. to audit which checks notify which endpoints.",
+
+		ReadContext: withDefaultOrgID(dataSourceNotificationRulesRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the notification rules. Defaults to the `org_id` of the provider.",
+			},
+			"check_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the notification rules matching the tags of the check with this ID, i.e. the rules the statuses of the check are sent by.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only list the notification rules matching all these tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"notification_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The notification rules, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the notification rule.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the notification rule.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the notification rule.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the notification endpoint of the rule, e.g. `slack`.",
+						},
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the notification endpoint the rule notifies.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the notification rule, `active` or `inactive`.",
+						},
+						"every": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The interval the rule runs at, e.g. `10m`.",
+						},
+						"offset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The delay of the runs of the rule after their scheduled time.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The tags the statuses must have to be notified, for the tag rules with the `equal` operator.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"levels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The levels of the statuses that are notified, e.g. `CRIT`, or `ANY`.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"last_run_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the last run: `success`, `failed` or `canceled`.",
+						},
+						"latest_completed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The scheduled time, as an RFC3339 timestamp, of the latest completed run.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the notification rules by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNotificationRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	filter := notificationRulesFilter{
+		orgID:   d.Get("org_id").(string),
+		checkID: d.Get("check_id").(string),
+		tags:    map[string]string{},
+	}
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		filter.tags[k] = v.(string)
+	}
+
+	log.Printf("[INFO] Reading Notification Rules (%+v)", filter)
+
+	all, err := api.findNotificationRules(ctx, filter)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the Notification Rules of Organization (%s): %v", filter.orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	rules := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, r := range all {
+		tags := map[string]interface{}{}
+		for _, t := range r.TagRules {
+			if t.Operator == "equal" {
+				tags[t.Key] = t.Value
+			}
+		}
+		levels := make([]interface{}, 0, len(r.StatusRules))
+		for _, s := range r.StatusRules {
+			levels = append(levels, s.CurrentLevel)
+		}
+		latestCompleted := ""
+		if r.LatestCompleted != nil {
+			latestCompleted = r.LatestCompleted.UTC().Format(time.RFC3339)
+		}
+		rules = append(rules, map[string]interface{}{
+			"id":               r.ID,
+			"name":             r.Name,
+			"description":      r.Description,
+			"type":             r.Type,
+			"endpoint_id":      r.EndpointID,
+			"status":           r.Status,
+			"every":            r.Every,
+			"offset":           r.Offset,
+			"tags":             tags,
+			"levels":           levels,
+			"last_run_status":  r.LastRunStatus,
+			"latest_completed": latestCompleted,
+		})
+		ids[r.Name] = r.ID
+		fmt.Fprintf(hash, "%s\n", r.ID)
+	}
+
+	log.Printf("[INFO] Found %d Notification Rules in Organization (%s)", len(rules), filter.orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("notification_rules", rules); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
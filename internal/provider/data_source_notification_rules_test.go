@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNotificationRules(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-rules") + "-"
+
+	orgID := testAccInitialOrgID(t)
+	endpointID := testAccNotificationEndpoint(t, orgID, prefix+"endpoint")
+	teamID := testAccNotificationRule(t, orgID, prefix+"team", endpointID, map[string]string{"team": prefix})
+	otherID := testAccNotificationRule(t, orgID, prefix+"other", endpointID, nil)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_notification_rules" "all" {
+						org_id = "%[1]s"
+					}
+					data "influxdb2_notification_rules" "team" {
+						org_id = "%[1]s"
+						tags   = {
+							team = "%[2]s"
+						}
+					}
+				`, orgID, prefix)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_notification_rules.all", "ids."+prefix+"team", teamID),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_rules.all", "ids."+prefix+"other", otherID),
+					resource.TestCheckResourceAttr("data.influxdb2_notification_rules.team", "ids."+prefix+"team", teamID),
+					resource.TestCheckNoResourceAttr("data.influxdb2_notification_rules.team", "ids."+prefix+"other"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_notification_rules.team", "notification_rules.*", map[string]string{
+						"endpoint_id": endpointID,
+						"every":       "10m",
+						"status":      "active",
+						"tags.team":   prefix,
+						"levels.0":    "CRIT",
+					}),
+				),
+			},
+		},
+	})
+}
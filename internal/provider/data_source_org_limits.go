@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgLimits() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup the quotas of an InfluxDB Cloud Organization, e.g. to assert them in `check` blocks or to size resources. The quotas are set by the Cloud plan and can't be changed through the API. A limit of `0` is unlimited. Only available on InfluxDB Cloud.",
+
+		ReadContext: withDefaultOrgID(dataSourceOrgLimitsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			// Computed outputs
+			"max_buckets": {
+				Description: "Maximum number of buckets, excluding system buckets.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"max_retention_period": {
+				Description: "Maximum retention period of a bucket, as a duration, e.g. `720h0m0s`, or `0s` when unlimited.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"max_tasks": {
+				Description: "Maximum number of tasks.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"max_dashboards": {
+				Description: "Maximum number of dashboards.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"max_checks": {
+				Description: "Maximum number of checks.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"max_notification_rules": {
+				Description: "Maximum number of notification rules.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"read_kbs": {
+				Description: "Read rate limit, in KB per second.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"write_kbs": {
+				Description: "Write rate limit, in KB per second.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"query_time": {
+				Description: "Query time limit, in nanoseconds per second.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"cardinality": {
+				Description: "Maximum series cardinality.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceOrgLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading limits of Organization (%s)", orgID)
+	limits, err := api.findOrgLimits(ctx, orgID)
+	if err != nil {
+		if isNotFound(err) {
+			return diag.Errorf("unable to retrieve limits of Organization (%s): only InfluxDB Cloud Organizations have limits: %v", orgID, err)
+		}
+		return diag.Errorf("unable to retrieve limits of Organization (%s): %v", orgID, err)
+	}
+
+	d.SetId(orgID)
+	d.Set("max_buckets", limits.Bucket.MaxBuckets)
+	d.Set("max_retention_period", time.Duration(limits.Bucket.MaxRetentionDuration).String())
+	d.Set("max_tasks", limits.Task.MaxTasks)
+	d.Set("max_dashboards", limits.Dashboard.MaxDashboards)
+	d.Set("max_checks", limits.Check.MaxChecks)
+	d.Set("max_notification_rules", limits.NotificationRule.MaxNotifications)
+	d.Set("read_kbs", limits.Rate.ReadKBs)
+	d.Set("write_kbs", limits.Rate.WriteKBs)
+	d.Set("query_time", limits.Rate.QueryTime)
+	d.Set("cardinality", limits.Rate.Cardinality)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Limits only exist on InfluxDB Cloud, so they are tested against the fake quota server.
+func TestDataSourceOrgLimitsRead(t *testing.T) {
+	meta := testQuotaServer(t, `{"orgID":"0000000000000001","bucket":{"maxBuckets":2,"maxRetentionDuration":2592000000000000},"task":{"maxTasks":5},"dashboard":{"maxDashboards":5},"check":{"maxChecks":2},"notificationRule":{"maxNotifications":2},"rate":{"readKBs":1000,"writeKBs":17,"queryTime":1500000000,"cardinality":10000}}`)
+
+	d := schema.TestResourceDataRaw(t, dataSourceOrgLimits().Schema, map[string]interface{}{
+		"org_id": "0000000000000001",
+	})
+	if diags := dataSourceOrgLimitsRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"max_buckets":            2,
+		"max_retention_period":   "720h0m0s",
+		"max_tasks":              5,
+		"max_checks":             2,
+		"max_notification_rules": 2,
+		"write_kbs":              17,
+		"cardinality":            10000,
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("expected %s %v, got %v", k, v, got)
+		}
+	}
+
+	meta = testQuotaServer(t, "")
+	d = schema.TestResourceDataRaw(t, dataSourceOrgLimits().Schema, map[string]interface{}{
+		"org_id": "0000000000000001",
+	})
+	diags := dataSourceOrgLimitsRead(context.Background(), d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "only InfluxDB Cloud") {
+		t.Fatalf("expected an error on OSS, got: %v", diags)
+	}
+}
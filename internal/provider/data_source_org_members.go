@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgMembers() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the members & owners of an Organization, e.g. for access reviews, or to detect memberships granted outside of Terraform.",
+
+		ReadContext: withDefaultOrgID(dataSourceOrgMembersRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The members & owners of the Organization, sorted by name. Owners are only listed once, with the `owner` role.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the user, `member` or `owner`.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the user, `active` or `inactive`.",
+						},
+					},
+				},
+			},
+			"member_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of the users with the `member` role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"owner_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of the users with the `owner` role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrgMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	path := "/api/v2/orgs/" + orgID
+
+	log.Printf("[INFO] Reading the members of Organization (%s)", orgID)
+
+	owners, err := api.findResourceUsers(ctx, path, "owners")
+	if err != nil {
+		return diag.Errorf("unable to retrieve the owners of Organization (%s): %v", orgID, err)
+	}
+	members, err := api.findResourceUsers(ctx, path, "members")
+	if err != nil {
+		return diag.Errorf("unable to retrieve the members of Organization (%s): %v", orgID, err)
+	}
+
+	users := map[string]resourceUser{}
+	ownerIDs := []string{}
+	memberIDs := []string{}
+	for _, u := range owners {
+		u.Role = "owner"
+		users[u.ID] = u
+		ownerIDs = append(ownerIDs, u.ID)
+	}
+	for _, u := range members {
+		if _, ok := users[u.ID]; ok {
+			continue
+		}
+		u.Role = "member"
+		users[u.ID] = u
+		memberIDs = append(memberIDs, u.ID)
+	}
+
+	all := make([]resourceUser, 0, len(users))
+	for _, u := range users {
+		all = append(all, u)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Name != all[j].Name {
+			return all[i].Name < all[j].Name
+		}
+		return all[i].ID < all[j].ID
+	})
+
+	flat := make([]interface{}, 0, len(all))
+	hash := sha256.New()
+	for _, u := range all {
+		status := u.Status
+		if status == "" {
+			status = "active"
+		}
+		flat = append(flat, map[string]interface{}{
+			"user_id": u.ID,
+			"name":    u.Name,
+			"role":    u.Role,
+			"status":  status,
+		})
+		fmt.Fprintf(hash, "%s/%s\n", u.ID, u.Role)
+	}
+
+	log.Printf("[INFO] Found %d owners & %d members in Organization (%s)", len(ownerIDs), len(memberIDs), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("members", flat); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("member_ids", memberIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("owner_ids", ownerIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
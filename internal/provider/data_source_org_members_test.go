@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceOrgMembers(t *testing.T) {
+	orgName := acctest.RandomWithPrefix("test-org")
+	userName := acctest.RandomWithPrefix("test-user")
+
+	userID := testAccUser(t, userName)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxOrgOwner(orgName, userID) + `
+					data "influxdb2_org_members" "members" {
+						org_id = influxdb2_organization.org.id
+
+						depends_on = [influxdb2_org_owner.owner]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.influxdb2_org_members.members", "owner_ids.*", userID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_org_members.members", "members.*", map[string]string{
+						"user_id": userID,
+						"name":    userName,
+						"role":    "owner",
+						"status":  "active",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,20 +32,35 @@ func dataSourceOrganization() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the Organization.",
 			},
+			"allow_missing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When `true`, a missing Organization isn't an error; instead `exists` is `false`, `name` & `id` keep the value looked up, or else `id` is a placeholder, and the other outputs are `null`. `exists` replaces the `id != null` pattern: use `count = data.influxdb2_organization.org.exists ? 1 : 0`.",
+			},
 			// Computed outputs
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The description of the Organization.",
 			},
+			"exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "`true` when the Organization was found, `false` when it is missing and `allow_missing` is set.",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the Organization is active.",
+			},
 		}, createdUpdatedSchema("Organization")),
 	}
 }
 
 func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// use the meta value to retrieve your client from the provider configure method
-	client := meta.(*metaData).client
-	orgAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	// Warning or errors can be collected in a slice type
 	var (
@@ -52,9 +69,15 @@ func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, met
 		err   error
 	)
 
+	allowMissing := d.Get("allow_missing").(bool)
+
 	if v, ok := d.GetOk("name"); ok {
 		orgName := v.(string)
-		if org, err = orgAPI.FindOrganizationByName(ctx, orgName); err != nil {
+		if org, err = api.findOrganizationByName(ctx, orgName); err != nil {
+			if allowMissing && isNotFound(err) {
+				log.Printf("[INFO] Organization with name (%s) not found, allow_missing is set", orgName)
+				return setMissingOrganization(d, fmt.Sprintf("missing-%x", sha256.Sum256([]byte(orgName))))
+			}
 			diags = append(diags, diag.FromErr(err)...)
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -64,7 +87,11 @@ func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	} else if v, ok := d.GetOk("id"); ok {
 		orgID := v.(string)
-		if org, err = orgAPI.FindOrganizationByID(ctx, orgID); err != nil {
+		if org, err = api.findOrganizationByID(ctx, orgID); err != nil {
+			if allowMissing && isNotFound(err) {
+				log.Printf("[INFO] Organization with id (%s) not found, allow_missing is set", orgID)
+				return setMissingOrganization(d, orgID)
+			}
 			diags = append(diags, diag.FromErr(err)...)
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -89,6 +116,24 @@ func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, met
 	if org.Description != nil {
 		d.Set("description", *org.Description)
 	}
+	if err := d.Set("exists", true); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_active", org.Status == nil || *org.Status == domain.OrganizationStatusActive); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
+
+// setMissingOrganization records an Organization that allow_missing let be missing. A data
+// source without an ID isn't stored, and Terraform reads every attribute of it as null,
+// exists included. The ID is also the id attribute, so it is the id looked up, or else a
+// placeholder that isn't an Organization ID.
+func setMissingOrganization(d *schema.ResourceData, id string) diag.Diagnostics {
+	d.SetId(id)
+	if err := d.Set("exists", false); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -42,8 +45,80 @@ func TestAccDataSourceOrganization(t *testing.T) {
 					resource.TestCheckResourceAttr("data.influxdb2_organization.by_name", "name", org),
 					resource.TestCheckResourceAttr("data.influxdb2_organization.by_id", "description", "test org"),
 					resource.TestCheckResourceAttr("data.influxdb2_organization.by_name", "description", "test org"),
+					resource.TestCheckResourceAttr("data.influxdb2_organization.by_id", "exists", "true"),
+					resource.TestCheckResourceAttr("data.influxdb2_organization.by_id", "is_active", "true"),
 				),
 			},
 		},
 	})
 }
+
+func TestAccDataSourceOrganizationAllowMissing(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org-missing")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_organization" "missing" {
+						name          = "%s"
+						allow_missing = true
+					}
+				`, org)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_organization.missing", "name", org),
+					resource.TestCheckNoResourceAttr("data.influxdb2_organization.missing", "description"),
+					resource.TestCheckResourceAttr("data.influxdb2_organization.missing", "exists", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceOrganizationMissing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v2/orgs" {
+			w.Write([]byte(`{"orgs":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"not found","message":"organization not found"}`))
+	}))
+	defer srv.Close()
+	md := &metaData{api: newAPIClient(srv.URL, "token")}
+
+	for _, lookup := range []map[string]interface{}{
+		{"name": "maybe-org"},
+		{"id": "0000000000000009"},
+	} {
+		d := schema.TestResourceDataRaw(t, dataSourceOrganization().Schema, lookup)
+		if diags := dataSourceOrganizationRead(context.Background(), d, md); !diags.HasError() {
+			t.Errorf("%v: expected an error unless allow_missing is set", lookup)
+		}
+
+		lookup["allow_missing"] = true
+		d = schema.TestResourceDataRaw(t, dataSourceOrganization().Schema, lookup)
+		if diags := dataSourceOrganizationRead(context.Background(), d, md); diags.HasError() {
+			t.Fatalf("%v: unexpected error: %v", lookup, diags)
+		}
+		state := d.State()
+		if state == nil {
+			t.Fatalf("%v: expected the missing Organization to be stored", lookup)
+		}
+		if state.Attributes["exists"] != "false" {
+			t.Errorf("%v: expected exists to be false, got %q", lookup, state.Attributes["exists"])
+		}
+		for _, k := range []string{"name", "id"} {
+			if v, ok := lookup[k]; ok && state.Attributes[k] != v {
+				t.Errorf("%v: expected %s to keep the value looked up, got %q", lookup, k, state.Attributes[k])
+			}
+		}
+		if _, ok := lookup["id"]; !ok && len(state.Attributes["id"]) == 16 {
+			t.Errorf("%v: expected a placeholder id that isn't an Organization ID, got %q", lookup, state.Attributes["id"])
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceOrganizations() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the Organizations the provider's token can read, e.g. to manage a resource per Organization with `for_each`.",
+
+		ReadContext: dataSourceOrganizationsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"organizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Organizations, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Organization.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Organization.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the Organization.",
+						},
+						"is_active": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the Organization is active.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the Organizations by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading all Organizations")
+
+	orgs, err := api.findOrganizations(ctx)
+	if err != nil {
+		return diag.Errorf("unable to retrieve Organizations: %v", err)
+	}
+	sort.Slice(orgs, func(i, j int) bool { return orgs[i].Name < orgs[j].Name })
+
+	res := make([]interface{}, 0, len(orgs))
+	ids := make(map[string]interface{}, len(orgs))
+	hash := sha256.New()
+	for _, org := range orgs {
+		res = append(res, map[string]interface{}{
+			"id":          stringValue(org.Id),
+			"name":        org.Name,
+			"description": stringValue(org.Description),
+			"is_active":   org.Status == nil || *org.Status == domain.OrganizationStatusActive,
+		})
+		ids[org.Name] = stringValue(org.Id)
+		fmt.Fprintf(hash, "%s\n", stringValue(org.Id))
+	}
+
+	log.Printf("[INFO] Found %d Organizations", len(res))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("organizations", res); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceOrganizations(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					data "influxdb2_organization" "initial" {
+						name = "` + testInitialOrg + `"
+					}
+					data "influxdb2_organizations" "all" {
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_organizations.all", "organizations.*", map[string]string{
+						"name":      testInitialOrg,
+						"is_active": "true",
+					}),
+					resource.TestCheckResourceAttrPair("data.influxdb2_organizations.all", "ids."+testInitialOrg, "data.influxdb2_organization.initial", "id"),
+				),
+			},
+		},
+	})
+}
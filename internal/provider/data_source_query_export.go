@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceQueryExport() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Run a Flux query and write its results to a local CSV or JSON file, e.g. to archive post-apply verification results in CI alongside the plan. Meant for small result sets: the query fails rather than writing a partial file when the result exceeds `max_rows` or `max_bytes`.",
+
+		ReadContext: withDefaultOrgID(dataSourceQueryExportRead),
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"query": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Flux query to run.",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"output_file": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Path of the local file to write the results to. It is overwritten on every read.",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization to run the query in. Defaults to the `org_id` of the provider.",
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "csv",
+				Description:      "Format of the file, `csv` or `json`. JSON files contain an array with one object per row.",
+				ValidateDiagFunc: validateStringInSlice([]string{"csv", "json"}, false),
+			},
+			"max_rows": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Maximum number of rows the query may return.",
+			},
+			"max_bytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1048576,
+				Description: "Maximum size of the file, in bytes.",
+			},
+			// Computed outputs
+			"row_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rows written to the file.",
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 checksum of the file contents.",
+			},
+		},
+	}
+}
+
+func dataSourceQueryExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	path := d.Get("output_file").(string)
+	maxRows := d.Get("max_rows").(int)
+	maxBytes := d.Get("max_bytes").(int)
+
+	log.Printf("[INFO] Running query in Organization (%s)", orgID)
+
+	raw, err := api.query(ctx, orgID, d.Get("query").(string))
+	if err != nil {
+		return diag.Errorf("unable to run query in Organization (%s): %v", orgID, err)
+	}
+	rows, err := parseAnnotatedCSV(raw)
+	if err != nil {
+		return diag.Errorf("unable to parse query results: %v", err)
+	}
+	if len(rows) > maxRows {
+		return diag.Errorf("query returned %d rows, more than max_rows (%d)", len(rows), maxRows)
+	}
+
+	content, err := encodeQueryRows(rows, d.Get("format").(string))
+	if err != nil {
+		return diag.Errorf("unable to encode query results: %v", err)
+	}
+	if len(content) > maxBytes {
+		return diag.Errorf("query results are %d bytes, more than max_bytes (%d)", len(content), maxBytes)
+	}
+
+	log.Printf("[INFO] Writing %d rows to (%s)", len(rows), path)
+	if err := ioutil.WriteFile(path, content, 0644); err != nil {
+		return diag.Errorf("unable to write query results to (%s): %v", path, err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	d.SetId(path)
+	if err := d.Set("row_count", len(rows)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content_sha256", hex.EncodeToString(sum[:])); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// encodeQueryRows encodes rows as CSV or JSON. CSV files have one column per column name
+// found in any row, sorted by name, since the tables of a query can have different columns.
+func encodeQueryRows(rows []map[string]string, format string) ([]byte, error) {
+	if format == "json" {
+		return json.Marshal(rows)
+	}
+
+	seen := map[string]bool{}
+	columns := []string{}
+	for _, row := range rows {
+		for k := range row {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+	sort.Strings(columns)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, c := range columns {
+			record[i] = row[c]
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
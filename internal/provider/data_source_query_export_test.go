@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseAnnotatedCSV(t *testing.T) {
+	raw := strings.Join([]string{
+		"#datatype,string,long,string,double",
+		"#group,false,false,true,false",
+		"#default,_result,,,",
+		",result,table,host,_value",
+		",_result,0,a,1.5",
+		",_result,0,a,2",
+		"",
+		"#datatype,string,long,string",
+		"#group,false,false,true",
+		"#default,_result,,",
+		",result,table,region",
+		",_result,1,\"eu, west\"",
+		"",
+	}, "\r\n")
+
+	rows, err := parseAnnotatedCSV(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]string{
+		{"result": "_result", "table": "0", "host": "a", "_value": "1.5"},
+		{"result": "_result", "table": "0", "host": "a", "_value": "2"},
+		{"result": "_result", "table": "1", "region": "eu, west"},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %v, got %v", expected, rows)
+	}
+
+	csvOut, err := encodeQueryRows(rows, "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedCSV := "_value,host,region,result,table\n1.5,a,,_result,0\n2,a,,_result,0\n,,\"eu, west\",_result,1\n"
+	if string(csvOut) != expectedCSV {
+		t.Errorf("expected CSV %q, got %q", expectedCSV, csvOut)
+	}
+}
+
+func TestReadAnnotatedCSVDatatypes(t *testing.T) {
+	raw := strings.Join([]string{
+		"#datatype,string,long,string,double",
+		"#group,false,false,true,false",
+		"#default,_result,,,",
+		",result,table,_field,_value",
+		",_result,0,usage,1.5",
+		"",
+		"#datatype,string,long,string,boolean",
+		"#group,false,false,true,false",
+		"#default,_result,,,",
+		",result,table,_field,_value",
+		",_result,1,online,true",
+		"",
+	}, "\r\n")
+
+	types := map[string]string{}
+	err := readAnnotatedCSV(raw, func(row map[string]string, datatypes map[string]string) error {
+		types[row["_field"]] = datatypes["_value"]
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"usage": "double", "online": "boolean"}
+	if !reflect.DeepEqual(types, expected) {
+		t.Fatalf("expected %v, got %v", expected, types)
+	}
+}
+
+func TestParseAnnotatedCSVError(t *testing.T) {
+	raw := "#datatype,string,string\n#group,true,true\n#default,,\n,error,reference\n,bucket not found,\n"
+
+	if _, err := parseAnnotatedCSV(raw); err == nil || !strings.Contains(err.Error(), "bucket not found") {
+		t.Fatalf("expected the query error to be returned, got: %v", err)
+	}
+}
+
+func TestAccDataSourceQueryExport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buckets.json")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_organization" "initial" {
+						name = "%s"
+					}
+					data "influxdb2_query_export" "buckets" {
+						org_id      = data.influxdb2_organization.initial.id
+						query       = "buckets() |> filter(fn: (r) => r.name == \"%s\") |> keep(columns: [\"name\"])"
+						output_file = "%s"
+						format      = "json"
+					}
+				`, testInitialOrg, testInitialBucket, path)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_query_export.buckets", "row_count", "1"),
+					func(s *terraform.State) error {
+						b, err := ioutil.ReadFile(path)
+						if err != nil {
+							return err
+						}
+						if !strings.Contains(string(b), testInitialBucket) {
+							return fmt.Errorf("expected %s in the exported file, got: %s", testInitialBucket, b)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
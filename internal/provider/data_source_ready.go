@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceReady() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Check that the InfluxDB2 server is ready, and read its version, build & uptime, e.g. to only create resources that need a recent InfluxDB2 release with `min_version`.",
+
+		ReadContext: dataSourceReadyRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"min_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateSemVer,
+				Description:      "A version to compare the version of the server with, e.g. `2.2.0`, see `meets_min_version`.",
+			},
+			// Computed outputs
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The readiness of the server, `ready`.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the server, e.g. `2.0.4`. Empty when the server doesn't report it.",
+			},
+			"build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build of the server, `OSS` or `Cloud`.",
+			},
+			"started": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the server started, as an RFC3339 timestamp.",
+			},
+			"uptime": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How long the server has been up, e.g. `1h2m3.5s`.",
+			},
+			"uptime_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How long the server has been up, in seconds.",
+			},
+			"meets_min_version": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the version of the server is `min_version` or later. `false` when the server doesn't report a version number, e.g. for Cloud, and `true` when `min_version` isn't set.",
+			},
+		},
+	}
+}
+
+// parseVersion returns the major, minor & patch numbers of a version like `2.0.4`,
+// `v2.0.4` or `2.1.0-rc1`.
+func parseVersion(version string) ([3]int, error) {
+	var res [3]int
+	core := strings.SplitN(strings.TrimPrefix(version, "v"), "-", 2)[0]
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return res, fmt.Errorf("invalid version %q", version)
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return res, fmt.Errorf("invalid version %q", version)
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
+// versionAtLeast returns whether version is min or later. Pre-releases are considered the
+// same as their release.
+func versionAtLeast(version string, min string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	m, err := parseVersion(min)
+	if err != nil {
+		return false, err
+	}
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] > m[i], nil
+		}
+	}
+	return true, nil
+}
+
+func dataSourceReadyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading the readiness of the server (%s)", api.host)
+
+	ready, err := api.ready(ctx)
+	if err != nil {
+		return diag.Errorf("InfluxDB2 server (%s) is not ready: %v", api.host, err)
+	}
+
+	meetsMinVersion := true
+	if min, ok := d.GetOk("min_version"); ok {
+		if meetsMinVersion, err = versionAtLeast(ready.Version, min.(string)); err != nil {
+			log.Printf("[WARN] unable to compare the version of InfluxDB2 server (%s) with %s: %v", api.host, min, err)
+		}
+	}
+
+	status := ""
+	if ready.Status != nil {
+		status = string(*ready.Status)
+	}
+	started := ""
+	if ready.Started != nil {
+		started = ready.Started.UTC().Format(time.RFC3339)
+	}
+	uptime := stringValue(ready.Up)
+	uptimeSeconds := 0
+	if up, err := time.ParseDuration(uptime); err == nil {
+		uptimeSeconds = int(up.Seconds())
+	}
+
+	d.SetId(api.host)
+	if err := d.Set("status", status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", ready.Version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build", ready.Build); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("started", started); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("uptime", uptime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("uptime_seconds", uptimeSeconds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("meets_min_version", meetsMinVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestVersionAtLeast(t *testing.T) {
+	for _, tc := range []struct {
+		version  string
+		min      string
+		expected bool
+		err      bool
+	}{
+		{version: "2.0.4", min: "2.0.4", expected: true},
+		{version: "2.0.4", min: "2.2.0", expected: false},
+		{version: "v2.2.0", min: "2.2.0", expected: true},
+		{version: "2.10.0", min: "2.2.0", expected: true},
+		{version: "2.2.0-rc1", min: "v2.2.0", expected: true},
+		{version: "3.0.0", min: "2.9.9", expected: true},
+		{version: "Cloud", min: "2.0.0", err: true},
+		{version: "", min: "2.0.0", err: true},
+	} {
+		ok, err := versionAtLeast(tc.version, tc.min)
+		if (err != nil) != tc.err {
+			t.Errorf("unexpected error for %s >= %s: %v", tc.version, tc.min, err)
+		}
+		if ok != tc.expected {
+			t.Errorf("expected %s >= %s to be %v", tc.version, tc.min, tc.expected)
+		}
+	}
+}
+
+func TestAccDataSourceReady(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					data "influxdb2_ready" "server" {
+						min_version = "2.0.0"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_ready.server", "status", "ready"),
+					resource.TestCheckResourceAttr("data.influxdb2_ready.server", "build", "OSS"),
+					resource.TestCheckResourceAttr("data.influxdb2_ready.server", "meets_min_version", "true"),
+					resource.TestCheckResourceAttrSet("data.influxdb2_ready.server", "started"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScripts() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the invokable scripts of the Organization of the provider's token, e.g. for HTTP integrations to discover the scripts provisioned by another team. Only supported by InfluxDB Cloud.",
+
+		ReadContext: dataSourceScriptsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"scripts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The scripts, sorted by name. Their Flux isn't included.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the script.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the script.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the script.",
+						},
+						"language": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The language of the script, e.g. `flux`.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL to invoke the script.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the scripts by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScriptsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading Scripts")
+
+	all, err := api.findScripts(ctx)
+	if isNotFound(err) || isForbidden(err) {
+		return diag.Errorf("the invokable scripts API isn't available on this server: it requires InfluxDB Cloud, and a token allowed to read scripts")
+	}
+	if err != nil {
+		return diag.Errorf("unable to retrieve Scripts: %v", err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	scripts := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, s := range all {
+		scripts = append(scripts, map[string]interface{}{
+			"id":          s.ID,
+			"name":        s.Name,
+			"description": s.Description,
+			"language":    s.Language,
+			"url":         s.URL,
+		})
+		ids[s.Name] = s.ID
+		fmt.Fprintf(hash, "%s\n", s.ID)
+	}
+
+	log.Printf("[INFO] Found %d Scripts", len(scripts))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("scripts", scripts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceScripts(t *testing.T) {
+	testAccSkipUnlessEndpoint(t, "/api/v2/scripts", nil)
+
+	name := acctest.RandomWithPrefix("test-scripts")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxScript(name, "listed", `from(bucket: params.bucket) |> range(start: -1h)`) + `
+					data "influxdb2_scripts" "all" {
+						depends_on = [influxdb2_script.script]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdb2_scripts.all", "ids."+name, "influxdb2_script.script", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_scripts.all", "scripts.*", map[string]string{
+						"name":        name,
+						"description": "listed",
+						"language":    "flux",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// The scripts API is only on InfluxDB Cloud, so reporting it as missing is tested against a
+// fake server.
+func TestDataSourceScriptsReadUnavailable(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusForbidden} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v2/scripts" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		}))
+		meta := &metaData{api: newAPIClient(srv.URL, "token")}
+
+		d := schema.TestResourceDataRaw(t, dataSourceScripts().Schema, map[string]interface{}{})
+		diags := dataSourceScriptsRead(context.Background(), d, meta)
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires InfluxDB Cloud") {
+			t.Errorf("expected HTTP %d to report the missing API, got: %v", status, diags)
+		}
+		srv.Close()
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServerConfig() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Read the runtime configuration of an InfluxDB2 OSS server, e.g. to check a setting in a precondition. It requires InfluxDB2 OSS 2.2 or later, and an operator token.",
+
+		ReadContext: dataSourceServerConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"config": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The settings, keyed by their name, e.g. `query-concurrency`. Strings are kept as is; other values, e.g. numbers & booleans, are JSON encoded.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"config_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The settings as a JSON object, to get typed values with `jsondecode()`.",
+			},
+		},
+	}
+}
+
+func dataSourceServerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading the server configuration")
+
+	config, err := api.serverConfig(ctx)
+	if isNotFound(err) {
+		return diag.Errorf("the configuration API isn't available on this server: it requires InfluxDB2 OSS 2.2 or later")
+	}
+	if err != nil {
+		return diag.Errorf("unable to retrieve the server configuration: %v", err)
+	}
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(api.host)
+	if err := d.Set("config", flattenJSONMap(config)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("config_json", string(configJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
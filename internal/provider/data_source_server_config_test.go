@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The test server runs a release without the configuration API, so the data source is
+// tested against a fake server.
+func TestDataSourceServerConfigRead(t *testing.T) {
+	status := http.StatusOK
+	body := `{"config":{"http-bind-address":":8086","query-concurrency":1024,"reporting-disabled":true,"tls-cert":""}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/config" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	meta := &metaData{api: newAPIClient(srv.URL, "token")}
+
+	d := schema.TestResourceDataRaw(t, dataSourceServerConfig().Schema, map[string]interface{}{})
+	if diags := dataSourceServerConfigRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	expected := map[string]string{
+		"config.http-bind-address":  ":8086",
+		"config.query-concurrency":  "1024",
+		"config.reporting-disabled": "true",
+		"config.tls-cert":           "",
+		"config_json":               `{"http-bind-address":":8086","query-concurrency":1024,"reporting-disabled":true,"tls-cert":""}`,
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	status = http.StatusNotFound
+	body = `{"code":"not found","message":"path not found"}`
+	d = schema.TestResourceDataRaw(t, dataSourceServerConfig().Schema, map[string]interface{}{})
+	diags := dataSourceServerConfigRead(context.Background(), d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires InfluxDB2 OSS 2.2 or later") {
+		t.Fatalf("expected the missing API to be reported, got: %v", diags)
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSetupAllowed() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Check whether an InfluxDB2 instance still has to be onboarded, e.g. to only create the `influxdb2_setup` resource on a fresh instance.",
+
+		ReadContext: dataSourceSetupAllowedRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed outputs
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the instance can be setup, i.e. it hasn't been onboarded yet.",
+			},
+		},
+	}
+}
+
+func dataSourceSetupAllowedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Checking whether InfluxDB2 can be setup")
+
+	allowed, err := api.isSetupAllowed(ctx)
+	if err != nil {
+		return diag.Errorf("unable to check whether InfluxDB2 can be setup: %v", err)
+	}
+
+	d.SetId(api.host)
+	if err := d.Set("allowed", allowed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
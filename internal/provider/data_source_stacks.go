@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStacks() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the stacks of an Organization with the template URLs they were applied from & the resources they track, e.g. to leave stack managed resources out of other automation.",
+
+		ReadContext: withDefaultOrgID(dataSourceStacksRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the stacks. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"stacks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The stacks, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the stack.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the stack.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the stack.",
+						},
+						"urls": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The URLs of the templates the stack was applied from.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"resources": stackResourcesSchema(),
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the stack was last updated.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the stacks by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resource_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of all the resources tracked by the stacks.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the Stacks of Organization (%s)", orgID)
+
+	all, err := api.findStacks(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the Stacks of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	stacks := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	resourceIDs := []string{}
+	hash := sha256.New()
+	for _, s := range all {
+		stacks = append(stacks, map[string]interface{}{
+			"id":          s.ID,
+			"name":        s.Name,
+			"description": s.Description,
+			"urls":        s.URLs,
+			"resources":   flattenStackResources(s.Resources),
+			"updated_at":  s.UpdatedAt.UTC().String(),
+		})
+		ids[s.Name] = s.ID
+		for _, r := range s.Resources {
+			if r.ResourceID != "" {
+				resourceIDs = append(resourceIDs, r.ResourceID)
+			}
+		}
+		fmt.Fprintf(hash, "%s\n", s.ID)
+	}
+
+	log.Printf("[INFO] Found %d Stacks in Organization (%s)", len(stacks), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("stacks", stacks); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resource_ids", resourceIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceStacks(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-stack")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxStack(name, "listed") + `
+					data "influxdb2_stacks" "all" {
+						org_id = data.influxdb2_organization.initial.id
+
+						depends_on = [influxdb2_stack.stack]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdb2_stacks.all", "ids."+name, "influxdb2_stack.stack", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_stacks.all", "stacks.*", map[string]string{
+						"name":        name,
+						"description": "listed",
+						"resources.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
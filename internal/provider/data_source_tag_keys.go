@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTagKeys() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the tag keys of the data written to a bucket since `range_start`, optionally of a single measurement, e.g. to generate dashboard templates from the live schema.",
+
+		ReadContext: withDefaultOrgID(dataSourceTagKeysRead),
+
+		Schema: mergeSchemas(schemaExplorationInputs(), map[string]*schema.Schema{
+			// Optional inputs
+			"measurement": schemaMeasurementInput("tag keys"),
+			// Computed outputs
+			"tag_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tag keys, sorted. The `_measurement`, `_field`, `_start` & `_stop` columns aren't included.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceTagKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	log.Printf("[INFO] Reading the tag keys of bucket (%s) in Organization (%s)", bucket, orgID)
+
+	keys, err := api.querySchemaValues(ctx, orgID, fmt.Sprintf("schema.tagKeys(bucket: %s, start: %s%s)", fluxString(bucket), d.Get("range_start").(string), schemaPredicate(d)))
+	if err != nil {
+		return diag.Errorf("unable to retrieve the tag keys of bucket (%s) in Organization (%s): %v", bucket, orgID, err)
+	}
+
+	tagKeys := make([]string, 0, len(keys))
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n", orgID, bucket, d.Get("measurement").(string))
+	for _, k := range keys {
+		if strings.HasPrefix(k, "_") {
+			continue
+		}
+		tagKeys = append(tagKeys, k)
+		fmt.Fprintf(hash, "%s\n", k)
+	}
+
+	log.Printf("[INFO] Found %d tag keys in bucket (%s)", len(tagKeys), bucket)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("tag_keys", tagKeys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceTagKeysAndValues(t *testing.T) {
+	measurement := acctest.RandomWithPrefix("test_measurement")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(influxSchemaData(bucketID, measurement) + fmt.Sprintf(`
+					data "influxdb2_tag_keys" "measurement" {
+						org_id      = data.influxdb2_organization.initial.id
+						bucket      = "%[1]s"
+						measurement = "%[2]s"
+
+						depends_on = [influxdb2_write.schema]
+					}
+					data "influxdb2_tag_values" "region" {
+						org_id      = data.influxdb2_organization.initial.id
+						bucket      = "%[1]s"
+						measurement = "%[2]s"
+						tag         = "region"
+
+						depends_on = [influxdb2_write.schema]
+					}
+				`, testInitialBucket, measurement)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_tag_keys.measurement", "tag_keys.#", "2"),
+					resource.TestCheckResourceAttr("data.influxdb2_tag_keys.measurement", "tag_keys.0", "host"),
+					resource.TestCheckResourceAttr("data.influxdb2_tag_keys.measurement", "tag_keys.1", "region"),
+					resource.TestCheckResourceAttr("data.influxdb2_tag_values.region", "tag_values.#", "2"),
+					resource.TestCheckResourceAttr("data.influxdb2_tag_values.region", "tag_values.0", "eu"),
+					resource.TestCheckResourceAttr("data.influxdb2_tag_values.region", "tag_values.1", "us"),
+				),
+			},
+		},
+	})
+}
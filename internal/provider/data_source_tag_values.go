@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTagValues() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the values of a tag in the data written to a bucket since `range_start`, optionally of a single measurement, e.g. to generate the values of a map variable from the live schema.",
+
+		ReadContext: withDefaultOrgID(dataSourceTagValuesRead),
+
+		Schema: mergeSchemas(schemaExplorationInputs(), map[string]*schema.Schema{
+			// Required inputs
+			"tag": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+				Description:      "The tag key to list the values of, e.g. `host`.",
+			},
+			// Optional inputs
+			"measurement": schemaMeasurementInput("tag values"),
+			// Computed outputs
+			"tag_values": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The values of the tag, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceTagValuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	bucket := d.Get("bucket").(string)
+	tag := d.Get("tag").(string)
+
+	log.Printf("[INFO] Reading the values of tag (%s) in bucket (%s) of Organization (%s)", tag, bucket, orgID)
+
+	values, err := api.querySchemaValues(ctx, orgID, fmt.Sprintf("schema.tagValues(bucket: %s, tag: %s, start: %s%s)", fluxString(bucket), fluxString(tag), d.Get("range_start").(string), schemaPredicate(d)))
+	if err != nil {
+		return diag.Errorf("unable to retrieve the values of tag (%s) in bucket (%s) of Organization (%s): %v", tag, bucket, orgID, err)
+	}
+
+	log.Printf("[INFO] Found %d values of tag (%s) in bucket (%s)", len(values), tag, bucket)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n%s\n", orgID, bucket, d.Get("measurement").(string), tag)
+	for _, v := range values {
+		fmt.Fprintf(hash, "%s\n", v)
+	}
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("tag_values", values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
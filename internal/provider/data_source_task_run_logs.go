@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTaskRunLogs() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Read the status & log lines of a run of a task, e.g. to check the outcome of an `influxdb2_task_run` backfill after apply, or to show why it failed.",
+
+		ReadContext: dataSourceTaskRunLogsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"task_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the task.",
+			},
+			"run_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the run.",
+			},
+			// Computed outputs
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the run: `scheduled`, `started`, `success`, `failed` or `canceled`.",
+			},
+			"logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The log lines of the run, oldest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the line was logged, as an RFC3339 timestamp.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message of the line.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskRunLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	taskID := d.Get("task_id").(string)
+	runID := d.Get("run_id").(string)
+
+	log.Printf("[INFO] Reading the logs of run (%s) of Task (%s)", runID, taskID)
+
+	run, err := api.findTaskRun(ctx, taskID, runID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve run (%s) of Task (%s): %v", runID, taskID, err)
+	}
+	events, err := api.findTaskRunLogs(ctx, taskID, runID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the logs of run (%s) of Task (%s): %v", runID, taskID, err)
+	}
+
+	logs := make([]interface{}, 0, len(events))
+	for _, e := range events {
+		t := ""
+		if e.Time != nil {
+			t = e.Time.UTC().Format(time.RFC3339Nano)
+		}
+		logs = append(logs, map[string]interface{}{
+			"time":    t,
+			"message": stringValue(e.Message),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", taskID, runID))
+	if run.Status != nil {
+		if err := d.Set("status", string(*run.Status)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("logs", logs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
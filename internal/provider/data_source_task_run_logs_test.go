@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceTaskRunLogsRead(t *testing.T) {
+	srv := testTaskRunServer(t, "failed")
+
+	d := schema.TestResourceDataRaw(t, dataSourceTaskRunLogs().Schema, map[string]interface{}{
+		"task_id": "0000000000000001",
+		"run_id":  "0000000000000002",
+	})
+	if diags := dataSourceTaskRunLogsRead(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("status").(string); got != "failed" {
+		t.Errorf("expected the failed status, got %q", got)
+	}
+	logs := d.Get("logs").([]interface{})
+	if len(logs) != 2 || logs[1].(map[string]interface{})["message"] != "error exhausting result iterator: bucket not found" {
+		t.Errorf("expected the 2 log lines, got %v", logs)
+	}
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceTasks() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the tasks the provider's token can read, optionally filtered by Organization, user & status, e.g. to report on all scheduled tasks. The Flux of the tasks isn't included.",
+
+		ReadContext: dataSourceTasksRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the tasks of the Organization with this ID.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the tasks owned by the user with this ID.",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{string(domain.TaskStatusTypeActive), string(domain.TaskStatusTypeInactive)}, false),
+				Description:      "Only list the tasks with this status, `active` or `inactive`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validateIntBetween(1, 10000),
+				Description:      "Maximum number of tasks to list, the first ones in ID order. Defaults to all tasks.",
+			},
+			// Computed outputs
+			"tasks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tasks, in ID order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the task.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the task.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the task.",
+						},
+						"org_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Organization that owns the task.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the task, `active` or `inactive`.",
+						},
+						"every": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The interval the task runs at, e.g. `1h`, when it isn't scheduled by `cron`.",
+						},
+						"cron": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cron expression the task runs at, when it isn't scheduled by `every`.",
+						},
+						"offset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The delay of the runs of the task after their scheduled time.",
+						},
+						"last_run_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the last run: `success`, `failed` or `canceled`.",
+						},
+						"last_run_error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error of the last run, when it failed.",
+						},
+						"latest_completed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The scheduled time, as an RFC3339 timestamp, of the latest completed run.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	filter := tasksFilter{
+		orgID:  d.Get("org_id").(string),
+		userID: d.Get("user_id").(string),
+		status: d.Get("status").(string),
+		limit:  d.Get("limit").(int),
+	}
+
+	log.Printf("[INFO] Reading tasks (%+v)", filter)
+
+	all, err := api.findTasks(ctx, filter)
+	if err != nil {
+		return diag.Errorf("unable to retrieve tasks: %v", err)
+	}
+
+	tasks := make([]interface{}, 0, len(all))
+	hash := sha256.New()
+	for _, t := range all {
+		status := string(domain.TaskStatusTypeActive)
+		if t.Status != nil {
+			status = string(*t.Status)
+		}
+		lastRunStatus := ""
+		if t.LastRunStatus != nil {
+			lastRunStatus = string(*t.LastRunStatus)
+		}
+		latestCompleted := ""
+		if t.LatestCompleted != nil {
+			latestCompleted = t.LatestCompleted.UTC().Format(time.RFC3339)
+		}
+		tasks = append(tasks, map[string]interface{}{
+			"id":               t.Id,
+			"name":             t.Name,
+			"description":      stringValue(t.Description),
+			"org_id":           t.OrgID,
+			"status":           status,
+			"every":            stringValue(t.Every),
+			"cron":             stringValue(t.Cron),
+			"offset":           stringValue(t.Offset),
+			"last_run_status":  lastRunStatus,
+			"last_run_error":   stringValue(t.LastRunError),
+			"latest_completed": latestCompleted,
+		})
+		fmt.Fprintf(hash, "%s\n", t.Id)
+	}
+
+	log.Printf("[INFO] Found %d tasks", len(tasks))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("tasks", tasks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
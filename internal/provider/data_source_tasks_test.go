@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// The docker-compose test server has no tasks, so filtering & paging are tested against a
+// fake server with more tasks than fit in a page.
+func TestFindTasks(t *testing.T) {
+	const taskCount = pageSize + 5
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("orgID") != "0000000000000001" || q.Get("status") != "active" || q.Get("user") != "" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		start := 0
+		if after := q.Get("after"); after != "" {
+			n, _ := strconv.ParseInt(after, 16, 64)
+			start = int(n) + 1
+		}
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		var tasks []map[string]string
+		for i := start; i < taskCount && i < start+limit; i++ {
+			tasks = append(tasks, map[string]string{"id": fmt.Sprintf("%016x", i), "name": fmt.Sprintf("task-%03d", i), "orgID": "0000000000000001", "flux": ""})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"tasks": tasks})
+	}))
+	t.Cleanup(srv.Close)
+	api := newAPIClient(srv.URL, "token")
+
+	for _, tc := range []struct {
+		limit    int
+		expected int
+	}{
+		{limit: 0, expected: taskCount},
+		{limit: 3, expected: 3},
+		{limit: pageSize + 2, expected: pageSize + 2},
+	} {
+		tasks, err := api.findTasks(context.Background(), tasksFilter{orgID: "0000000000000001", status: "active", limit: tc.limit})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tasks) != tc.expected {
+			t.Errorf("expected %d tasks with limit %d, got %d", tc.expected, tc.limit, len(tasks))
+		}
+		if tasks[len(tasks)-1].Name != fmt.Sprintf("task-%03d", tc.expected-1) {
+			t.Errorf("expected tasks in ID order, got %s last", tasks[len(tasks)-1].Name)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTelegrafConfig() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a Telegraf configuration stored in InfluxDB2 by name or ID, including its TOML, e.g. to push the agent configuration into the user data of instances.",
+
+		ReadContext: dataSourceTelegrafConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
+				Description:  "ID of the Organization that owns the Telegraf configuration. Used to lookup the configuration by name, defaults to the `org_id` of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the Telegraf configuration.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Telegraf configuration.",
+			},
+			// Computed outputs
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the Telegraf configuration.",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The TOML of the Telegraf configuration. Secrets are left as environment variable references, e.g. `$INFLUX_TOKEN`.",
+			},
+			"buckets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the buckets the configuration writes to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the labels of the Telegraf configuration.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTelegrafConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		t   *telegraf
+		err error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		orgID := d.Get("org_id").(string)
+		log.Printf("[INFO] Reading Telegraf configuration with name (%s) of Organization (%s)", name, orgID)
+		if t, err = api.findTelegrafByName(ctx, orgID, name); err != nil {
+			return diag.Errorf("unable to retrieve Telegraf configuration with name (%s) of Organization (%s): %v", name, orgID, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading Telegraf configuration (%s)", id)
+		if t, err = api.findTelegrafByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve Telegraf configuration (%s): %v", id, err)
+		}
+	}
+
+	if err := setTelegrafConfigData(d, t); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func setTelegrafConfigData(d *schema.ResourceData, t *telegraf) error {
+	labels := make([]string, 0, len(t.Labels))
+	for _, l := range t.Labels {
+		labels = append(labels, stringValue(l.Name))
+	}
+
+	d.SetId(t.ID)
+	if err := d.Set("id", t.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", t.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", t.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", t.Description); err != nil {
+		return err
+	}
+	if err := d.Set("config", t.Config); err != nil {
+		return err
+	}
+	if err := d.Set("buckets", t.Metadata.Buckets); err != nil {
+		return err
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+	return nil
+}
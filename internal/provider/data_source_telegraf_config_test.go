@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testTelegrafConfig = `[[inputs.cpu]]
+  percpu = false
+
+[[outputs.influxdb_v2]]
+  urls = ["http://localhost:8086"]
+  token = "$INFLUX_TOKEN"
+  organization = "initial-org"
+  bucket = "initial-bucket"
+`
+
+func TestAccDataSourceTelegrafConfig(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-telegraf")
+
+	orgID := testAccInitialOrgID(t)
+	telegrafID := testAccTelegrafConfig(t, orgID, name, testTelegrafConfig)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_telegraf_config" "by_name" {
+						org_id = "%s"
+						name   = "%s"
+					}
+					data "influxdb2_telegraf_config" "by_id" {
+						id = "%s"
+					}
+				`, orgID, name, telegrafID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_telegraf_config.by_name", "id", telegrafID),
+					resource.TestCheckResourceAttr("data.influxdb2_telegraf_config.by_name", "config", testTelegrafConfig),
+					resource.TestCheckResourceAttr("data.influxdb2_telegraf_config.by_name", "buckets.0", testInitialBucket),
+					resource.TestCheckResourceAttr("data.influxdb2_telegraf_config.by_id", "name", name),
+				),
+			},
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_telegraf_config" "missing" {
+						org_id = "%s"
+						name   = "missing-telegraf"
+					}
+				`, orgID)),
+				ExpectError: regexp.MustCompile(`unable to retrieve Telegraf configuration with name \(missing-telegraf\)`),
+			},
+		},
+	})
+}
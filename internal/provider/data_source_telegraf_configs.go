@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTelegrafConfigs() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the Telegraf configurations stored in an Organization, e.g. for fleets to discover which configurations exist. Use `influxdb2_telegraf_config` to read the TOML of a configuration.",
+
+		ReadContext: withDefaultOrgID(dataSourceTelegrafConfigsRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the Telegraf configurations. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"telegraf_configs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Telegraf configurations, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Telegraf configuration.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Telegraf configuration.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the Telegraf configuration.",
+						},
+						"buckets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The names of the buckets the configuration writes to.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"labels": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "The names of the labels of the Telegraf configuration.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the Telegraf configurations by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTelegrafConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the Telegraf configurations of Organization (%s)", orgID)
+
+	all, err := api.findTelegrafs(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the Telegraf configurations of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	configs := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, t := range all {
+		labels := make([]interface{}, 0, len(t.Labels))
+		for _, l := range t.Labels {
+			labels = append(labels, stringValue(l.Name))
+		}
+		configs = append(configs, map[string]interface{}{
+			"id":          t.ID,
+			"name":        t.Name,
+			"description": t.Description,
+			"buckets":     t.Metadata.Buckets,
+			"labels":      labels,
+		})
+		ids[t.Name] = t.ID
+		fmt.Fprintf(hash, "%s\n", t.ID)
+	}
+
+	log.Printf("[INFO] Found %d Telegraf configurations in Organization (%s)", len(configs), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("telegraf_configs", configs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
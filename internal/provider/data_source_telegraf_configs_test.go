@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceTelegrafConfigs(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-telegrafs")
+
+	orgID := testAccInitialOrgID(t)
+	telegrafID := testAccTelegrafConfig(t, orgID, name, testTelegrafConfig)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_telegraf_configs" "all" {
+						org_id = "%s"
+					}
+				`, orgID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_telegraf_configs.all", "ids."+name, telegrafID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_telegraf_configs.all", "telegraf_configs.*", map[string]string{
+						"id":        telegrafID,
+						"buckets.0": testInitialBucket,
+					}),
+				),
+			},
+		},
+	})
+}
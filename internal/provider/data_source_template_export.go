@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// templateExportKinds are the kinds of resources that can be exported as a template.
+var templateExportKinds = []string{
+	"Bucket",
+	"Check",
+	"Dashboard",
+	"Label",
+	"NotificationEndpoint",
+	"NotificationRule",
+	"Task",
+	"Telegraf",
+	"Variable",
+}
+
+func dataSourceTemplateExport() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Export existing resources as an InfluxDB2 template, e.g. to capture a dashboard created in the UI and apply it elsewhere with `influxdb2_template_apply`. The labels of the resources are exported with them.",
+
+		ReadContext: dataSourceTemplateExportRead,
+
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"resource": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "A resource to export.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringInSlice(templateExportKinds, false),
+							Description:      "The kind of the resource, e.g. `Dashboard` or `Task`.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the resource.",
+						},
+					},
+				},
+			},
+			// Computed outputs
+			"yaml": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template, as YAML documents.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template, as a JSON list.",
+			},
+		},
+	}
+}
+
+func dataSourceTemplateExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	export := &templateExport{}
+	hash := sha256.New()
+	for _, v := range d.Get("resource").([]interface{}) {
+		r := v.(map[string]interface{})
+		export.Resources = append(export.Resources, templateExportResource{
+			Kind: r["kind"].(string),
+			ID:   r["id"].(string),
+		})
+		fmt.Fprintf(hash, "%s/%s\n", r["kind"], r["id"])
+	}
+
+	log.Printf("[INFO] Exporting %d resources as a template", len(export.Resources))
+
+	objects, err := api.exportTemplate(ctx, export)
+	if err != nil {
+		return diag.Errorf("unable to export the template: %v", err)
+	}
+	yamlTemplate, err := templateYAML(objects)
+	if err != nil {
+		return diag.Errorf("unable to convert the template to YAML: %v", err)
+	}
+	var jsonTemplate bytes.Buffer
+	if err := json.Indent(&jsonTemplate, objects, "", "  "); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("yaml", yamlTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("json", jsonTemplate.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceTemplateExport(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_template_export" "test" {
+						resource {
+							kind = "Bucket"
+							id   = "%s"
+						}
+					}
+				`, bucketID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.influxdb2_template_export.test", "yaml", regexp.MustCompile(`(?m)^kind: Bucket$`)),
+					resource.TestMatchResourceAttr("data.influxdb2_template_export.test", "yaml", regexp.MustCompile(`(?m)^  name: `+testInitialBucket+`$`)),
+					resource.TestMatchResourceAttr("data.influxdb2_template_export.test", "json", regexp.MustCompile(`"kind": "Bucket"`)),
+				),
+			},
+		},
+	})
+}
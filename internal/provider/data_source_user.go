@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Lookup a user in InfluxDB2 by name or ID, e.g. to make a user created outside of Terraform an owner or member.",
+
+		ReadContext: dataSourceUserRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  "Name of the user.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the user.",
+			},
+			// Computed outputs
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the user, `active` or `inactive`.",
+			},
+			"oauth_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OAuth ID of the user, if any.",
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	var (
+		u   *domain.User
+		err error
+	)
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		log.Printf("[INFO] Reading user with name (%s)", name)
+		if u, err = api.findUserByName(ctx, name); err != nil {
+			return diag.Errorf("unable to retrieve user with name (%s): %v", name, err)
+		}
+	} else {
+		id := d.Get("id").(string)
+		log.Printf("[INFO] Reading user (%s)", id)
+		if u, err = api.findUserByID(ctx, id); err != nil {
+			return diag.Errorf("unable to retrieve user (%s): %v", id, err)
+		}
+	}
+	if u.Id == nil {
+		return diag.Errorf("user not found")
+	}
+
+	d.SetId(*u.Id)
+	if err := d.Set("id", *u.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", u.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", userStatus(*u)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", stringValue(u.OauthID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
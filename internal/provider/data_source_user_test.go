@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceUser(t *testing.T) {
+	userName := acctest.RandomWithPrefix("test-user")
+
+	userID := testAccUser(t, userName)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_user" "by_name" {
+						name = "%s"
+					}
+					data "influxdb2_user" "by_id" {
+						id = "%s"
+					}
+				`, userName, userID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_user.by_name", "id", userID),
+					resource.TestCheckResourceAttr("data.influxdb2_user.by_name", "status", "active"),
+					resource.TestCheckResourceAttr("data.influxdb2_user.by_id", "name", userName),
+				),
+			},
+			{
+				Config: testConfig(`
+					data "influxdb2_user" "missing" {
+						name = "missing-user"
+					}
+				`),
+				ExpectError: regexp.MustCompile(`unable to retrieve user with name \(missing-user\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the users of the instance the provider's token can read, optionally filtered by name prefix, e.g. to audit users or manage memberships in bulk with `for_each`.",
+
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the users whose name starts with the prefix.",
+			},
+			// Computed outputs
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the user, `active` or `inactive`.",
+						},
+						"oauth_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The OAuth ID of the user, if any.",
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the users by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	log.Printf("[INFO] Reading all users")
+
+	all, err := api.findUsers(ctx)
+	if err != nil {
+		return diag.Errorf("unable to retrieve users: %v", err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	namePrefix := d.Get("name_prefix").(string)
+
+	users := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, u := range all {
+		if !strings.HasPrefix(u.Name, namePrefix) {
+			continue
+		}
+		users = append(users, map[string]interface{}{
+			"id":       stringValue(u.Id),
+			"name":     u.Name,
+			"status":   userStatus(u),
+			"oauth_id": stringValue(u.OauthID),
+		})
+		ids[u.Name] = stringValue(u.Id)
+		fmt.Fprintf(hash, "%s\n", stringValue(u.Id))
+	}
+
+	log.Printf("[INFO] Found %d users", len(users))
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
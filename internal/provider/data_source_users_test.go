@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-users") + "-"
+
+	aliceID := testAccUser(t, prefix+"alice")
+	bobID := testAccUser(t, prefix+"bob")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_users" "users" {
+						name_prefix = "%s"
+					}
+				`, prefix)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_users.users", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.influxdb2_users.users", "users.0.id", aliceID),
+					resource.TestCheckResourceAttr("data.influxdb2_users.users", "users.0.status", "active"),
+					resource.TestCheckResourceAttr("data.influxdb2_users.users", "ids."+prefix+"bob", bobID),
+				),
+			},
+		},
+	})
+}
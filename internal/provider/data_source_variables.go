@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVariables() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the dashboard variables of an Organization with their types & values, e.g. to audit unused variables or generate templates.",
+
+		ReadContext: withDefaultOrgID(dataSourceVariablesRead),
+
+		Schema: map[string]*schema.Schema{
+			// Optional inputs
+			"org_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the Organization that owns the variables. Defaults to the `org_id` of the provider.",
+			},
+			// Computed outputs
+			"variables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The variables, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the variable.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the variable.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the variable.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the variable: `constant`, `map` or `query`.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The values of a `constant` variable.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"map_values": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The values of a `map` variable, by key.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"query": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The query of a `query` variable.",
+						},
+						"language": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The language of the query of a `query` variable, e.g. `flux`.",
+						},
+						"selected": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The selected values of the variable.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"labels": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "The names of the labels of the variable.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the variables by name, e.g. for `for_each`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// flattenVariable returns the attributes of a variable, decoding the values of its
+// arguments according to their type.
+func flattenVariable(v variable) (map[string]interface{}, error) {
+	var (
+		values    []string
+		mapValues map[string]string
+		query     variableQuery
+		err       error
+	)
+	if len(v.Arguments.Values) > 0 {
+		switch v.Arguments.Type {
+		case "constant":
+			err = json.Unmarshal(v.Arguments.Values, &values)
+		case "map":
+			err = json.Unmarshal(v.Arguments.Values, &mapValues)
+		case "query":
+			err = json.Unmarshal(v.Arguments.Values, &query)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid values of %s variable %s: %v", v.Arguments.Type, v.Name, err)
+	}
+
+	labels := make([]interface{}, 0, len(v.Labels))
+	for _, l := range v.Labels {
+		labels = append(labels, stringValue(l.Name))
+	}
+	return map[string]interface{}{
+		"id":          v.ID,
+		"name":        v.Name,
+		"description": v.Description,
+		"type":        v.Arguments.Type,
+		"values":      values,
+		"map_values":  mapValues,
+		"query":       query.Query,
+		"language":    query.Language,
+		"selected":    v.Selected,
+		"labels":      labels,
+	}, nil
+}
+
+func dataSourceVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	log.Printf("[INFO] Reading the variables of Organization (%s)", orgID)
+
+	all, err := api.findVariables(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the variables of Organization (%s): %v", orgID, err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	vars := make([]interface{}, 0, len(all))
+	ids := make(map[string]interface{}, len(all))
+	hash := sha256.New()
+	for _, v := range all {
+		flat, err := flattenVariable(v)
+		if err != nil {
+			return diag.Errorf("unable to read the variables of Organization (%s): %v", orgID, err)
+		}
+		vars = append(vars, flat)
+		ids[v.Name] = v.ID
+		fmt.Fprintf(hash, "%s\n", v.ID)
+	}
+
+	log.Printf("[INFO] Found %d variables in Organization (%s)", len(vars), orgID)
+
+	d.SetId(hex.EncodeToString(hash.Sum(nil)))
+	if err := d.Set("variables", vars); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
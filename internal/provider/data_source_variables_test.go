@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenVariable(t *testing.T) {
+	for _, tc := range []struct {
+		arguments string
+		key       string
+		expected  interface{}
+	}{
+		{arguments: `{"type":"constant","values":["a","b"]}`, key: "values", expected: []string{"a", "b"}},
+		{arguments: `{"type":"map","values":{"k":"v"}}`, key: "map_values", expected: map[string]string{"k": "v"}},
+		{arguments: `{"type":"query","values":{"query":"buckets()","language":"flux"}}`, key: "query", expected: "buckets()"},
+	} {
+		var v variable
+		if err := json.Unmarshal([]byte(`{"name":"v","arguments":`+tc.arguments+`}`), &v); err != nil {
+			t.Fatal(err)
+		}
+		flat, err := flattenVariable(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(flat[tc.key], tc.expected) {
+			t.Errorf("expected %s %v for %s, got %v", tc.key, tc.expected, tc.arguments, flat[tc.key])
+		}
+	}
+
+	var invalid variable
+	json.Unmarshal([]byte(`{"name":"v","arguments":{"type":"constant","values":{"k":"v"}}}`), &invalid)
+	if _, err := flattenVariable(invalid); err == nil {
+		t.Error("expected an error for invalid values")
+	}
+}
+
+func TestAccDataSourceVariables(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test_variables") + "_"
+
+	orgID := testAccInitialOrgID(t)
+	constantID := testAccVariable(t, orgID, prefix+"constant", map[string]interface{}{
+		"type":   "constant",
+		"values": []string{"us-east", "eu-west"},
+	})
+	queryID := testAccVariable(t, orgID, prefix+"query", map[string]interface{}{
+		"type":   "query",
+		"values": map[string]string{"query": "buckets()", "language": "flux"},
+	})
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_variables" "all" {
+						org_id = "%s"
+					}
+				`, orgID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb2_variables.all", "ids."+prefix+"constant", constantID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_variables.all", "variables.*", map[string]string{
+						"id":       constantID,
+						"type":     "constant",
+						"values.#": "2",
+						"values.1": "eu-west",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb2_variables.all", "variables.*", map[string]string{
+						"id":       queryID,
+						"type":     "query",
+						"query":    "buckets()",
+						"language": "flux",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withDefaultOrgID wraps the create function of a resource, or the read function of a data
+// source, whose org_id argument defaults to the Organization of the provider. org_id is set
+// before fn is called, so that fn can keep using d.Get("org_id").
+func withDefaultOrgID(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := setDefaultOrgID(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return fn(ctx, d, meta)
+	}
+}
+
+// setDefaultOrgID sets org_id to the Organization of the provider, unless it is set already.
+func setDefaultOrgID(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("org_id"); ok {
+		return nil
+	}
+	orgID := meta.(*metaData).orgID
+	if orgID == "" {
+		return errors.New("org_id must be set when neither the org nor the org_id provider argument is set")
+	}
+	return d.Set("org_id", orgID)
+}
+
+// suppressDefaultOrgID suppresses the diff of an org_id argument left unset, which holds the
+// Organization of the provider it defaulted to at create. It is used instead of Computed
+// where org_id is needed at plan time, since an unset Computed org_id is unknown until apply.
+func suppressDefaultOrgID(k, old, new string, d *schema.ResourceData) bool {
+	return new == ""
+}
+
+// diffOrgID returns the Organization a resource is planned in: its org_id argument, or the
+// Organization of the provider when unset. ok is false while org_id isn't known.
+func diffOrgID(d *schema.ResourceDiff, meta interface{}) (orgID string, ok bool) {
+	if !d.NewValueKnown("org_id") {
+		return "", false
+	}
+	if orgID = d.Get("org_id").(string); orgID == "" {
+		orgID = meta.(*metaData).orgID
+	}
+	return orgID, orgID != ""
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeFlux returns a Flux script without the differences the UI & API introduce when
+// saving it: line endings, trailing whitespace and leading or trailing blank lines.
+func normalizeFlux(script string) string {
+	lines := strings.Split(strings.ReplaceAll(script, "\r\n", "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+func suppressEquivalentFlux(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeFlux(old) == normalizeFlux(new)
+}
+
+// fluxDiff returns a line diff of two normalized Flux scripts, with lines prefixed by `-`
+// when only in old, `+` when only in new, and a space when in both. It is empty when the
+// scripts are equivalent.
+func fluxDiff(old, new string) string {
+	a := strings.Split(normalizeFlux(old), "\n")
+	b := strings.Split(normalizeFlux(new), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var sb strings.Builder
+	changed := false
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("- " + a[i] + "\n")
+			changed = true
+			i++
+		default:
+			sb.WriteString("+ " + b[j] + "\n")
+			changed = true
+			j++
+		}
+	}
+	if !changed {
+		return ""
+	}
+	return sb.String()
+}
+
+// fluxString returns s as a Flux string literal, escaping the characters Flux interprets:
+// backslashes, double quotes & the `${` of interpolations.
+func fluxString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", `\${`).Replace(s) + `"`
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeFlux(t *testing.T) {
+	got := normalizeFlux("\r\nfrom(bucket: \"b\")  \r\n  |> range(start: -1h)\t\n\n")
+	want := "from(bucket: \"b\")\n  |> range(start: -1h)"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestFluxDiff(t *testing.T) {
+	old := "from(bucket: \"b\")\n  |> range(start: -1h)\n  |> mean()\n"
+	if diff := fluxDiff(old, old+"\n   "); diff != "" {
+		t.Fatalf("expected no diff for equivalent scripts, got:\n%s", diff)
+	}
+
+	diff := fluxDiff(old, "from(bucket: \"b\")\n  |> range(start: -2h)\n  |> mean()")
+	want := "  from(bucket: \"b\")\n-   |> range(start: -1h)\n+   |> range(start: -2h)\n    |> mean()\n"
+	if diff != want {
+		t.Fatalf("expected:\n%s\ngot:\n%s", want, diff)
+	}
+}
+
+func TestFluxString(t *testing.T) {
+	for s, want := range map[string]string{
+		"initial-bucket": `"initial-bucket"`,
+		`say "hi"`:       `"say \"hi\""`,
+		`C:\data`:        `"C:\\data"`,
+		"${r._value}":    `"\${r._value}"`,
+	} {
+		if got := fluxString(s); got != want {
+			t.Errorf("expected %s for %q, got %s", want, s, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,3 +41,44 @@ func mergeSchemas(schemas ...map[string]*schema.Schema) map[string]*schema.Schem
 	}
 	return res
 }
+
+// stringValue dereferences an optional string from the InfluxDB2 API, returning "" for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// int32Value dereferences an optional int32 from the InfluxDB2 API, returning 0 for nil.
+func int32Value(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// expandStringList converts a TypeList of strings from the configuration to a []string.
+func expandStringList(list []interface{}) []string {
+	res := make([]string, 0, len(list))
+	for _, v := range list {
+		s, _ := v.(string)
+		res = append(res, s)
+	}
+	return res
+}
+
+// flattenJSONMap converts a JSON object with values of any type to a TypeMap of strings:
+// strings are kept as is, other values are JSON encoded.
+func flattenJSONMap(m map[string]json.RawMessage) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			res[k] = s
+		} else {
+			res[k] = string(raw)
+		}
+	}
+	return res
+}
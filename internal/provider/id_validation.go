@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// influxIDPattern matches the IDs generated by InfluxDB2: 16 lowercase hex characters.
+var influxIDPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// validateResourceIDs adds a plan time check to every resource that the `*_id` arguments set
+// in the configuration look like InfluxDB2 IDs, when `validate_ids` is set on the provider.
+// This catches a name pasted into an ID argument, which otherwise only fails at apply.
+// Values only known at apply, e.g. references to other resources, are skipped.
+func validateResourceIDs(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		attributes := idAttributes(r)
+		if len(attributes) == 0 {
+			continue
+		}
+
+		next := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if md, ok := meta.(*metaData); ok && md.validateIDs {
+				for _, k := range attributes {
+					if !d.NewValueKnown(k) {
+						continue
+					}
+					if v := d.Get(k).(string); v != "" && !influxIDPattern.MatchString(v) {
+						return fmt.Errorf("%s (%s) is not a valid InfluxDB2 ID, expected 16 lowercase hex characters", k, v)
+					}
+				}
+			}
+			if next != nil {
+				return next(ctx, d, meta)
+			}
+			return nil
+		}
+	}
+}
+
+// idAttributes returns the top level string arguments of r named `*_id`.
+func idAttributes(r *schema.Resource) []string {
+	var res []string
+	for k, s := range r.Schema {
+		if strings.HasSuffix(k, "_id") && s.Type == schema.TypeString && (s.Required || s.Optional) {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknownValue is how the SDK represents a value only known at apply in a raw configuration.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestValidateResourceIDs(t *testing.T) {
+	cases := []struct {
+		name     string
+		enabled  bool
+		bucketID string
+		err      string
+	}{
+		{"valid", true, "0a1b2c3d4e5f6a7b", ""},
+		{"name instead of ID", true, "initial-bucket", "bucket_id (initial-bucket) is not a valid InfluxDB2 ID"},
+		{"uppercase", true, "0A1B2C3D4E5F6A7B", "is not a valid InfluxDB2 ID"},
+		{"unknown until apply", true, unknownValue, ""},
+		{"disabled", false, "initial-bucket", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resources := map[string]*schema.Resource{"influxdb2_bucket_member": resourceBucketMember()}
+			validateResourceIDs(resources)
+			r := resources["influxdb2_bucket_member"]
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"bucket_id": c.bucketID,
+				"user_id":   "0000000000000001",
+			})
+			_, err := r.Diff(context.Background(), nil, config, &metaData{validateIDs: c.enabled})
+
+			if c.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestIDAttributes(t *testing.T) {
+	got := strings.Join(idAttributes(resourceSetup()), ",")
+	if got != "" {
+		t.Errorf("expected computed ID attributes to be skipped, got %q", got)
+	}
+	got = strings.Join(idAttributes(resourceBucketMember()), ",")
+	if got != "bucket_id,user_id" {
+		t.Errorf("expected bucket_id,user_id, got %q", got)
+	}
+}
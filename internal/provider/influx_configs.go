@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// influxConfig is a connection profile of the influx CLI, as created by `influx config create`.
+type influxConfig struct {
+	Name   string
+	URL    string
+	Token  string
+	Org    string
+	Active bool
+}
+
+// defaultInfluxConfigsPath returns the path of the file the influx CLI keeps its profiles in.
+func defaultInfluxConfigsPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".influxdbv2", "configs"), nil
+}
+
+// influxProfile reads the profile of the provider profile & config_file arguments, which
+// default to the active profile and the configs file of the CLI.
+func influxProfile(profile string, path string) (*influxConfig, error) {
+	if path == "" {
+		var err error
+		if path, err = defaultInfluxConfigsPath(); err != nil {
+			return nil, err
+		}
+	}
+	return readInfluxConfig(path, profile)
+}
+
+// readInfluxConfig returns the profile with the given name from an influx CLI configs file,
+// or the active profile when name is empty.
+func readInfluxConfig(path string, name string) (*influxConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	configs, err := parseInfluxConfigs(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", path, err)
+	}
+	for i := range configs {
+		if (name == "" && configs[i].Active) || (name != "" && configs[i].Name == name) {
+			return &configs[i], nil
+		}
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no active profile in %s", path)
+	}
+	return nil, fmt.Errorf("no profile named %q in %s", name, path)
+}
+
+// parseInfluxConfigs parses the profiles of an influx CLI configs file. The file is TOML,
+// but the CLI only writes a table per profile with string & boolean keys, so only that
+// subset is supported. Other keys, e.g. the previous flag of the CLI, are ignored unparsed.
+func parseInfluxConfigs(r io.Reader) ([]influxConfig, error) {
+	var configs []influxConfig
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid profile name %s", n, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if unquoted, err := unquoteTOML(name); err == nil {
+				name = unquoted
+			}
+			configs = append(configs, influxConfig{Name: name})
+			continue
+		}
+
+		eq := strings.Index(line, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if len(configs) == 0 {
+			return nil, fmt.Errorf("line %d: key outside of a profile", n)
+		}
+		key := strings.TrimSpace(line[:eq])
+		raw := strings.TrimSpace(line[eq+1:])
+		c := &configs[len(configs)-1]
+
+		var value *string
+		switch key {
+		case "active":
+			active, err := strconv.ParseBool(raw)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid active value %s", n, raw)
+			}
+			c.Active = active
+			continue
+		case "url":
+			value = &c.URL
+		case "token":
+			value = &c.Token
+		case "org":
+			value = &c.Org
+		default:
+			continue
+		}
+		unquoted, err := unquoteTOML(raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid %s value: %v", n, key, err)
+		}
+		*value = unquoted
+	}
+	return configs, scanner.Err()
+}
+
+// unquoteTOML unquotes a TOML basic ("...") or literal ('...') string.
+func unquoteTOML(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return s[1 : len(s)-1], nil
+	}
+	if len(s) >= 2 && s[0] == '"' {
+		return strconv.Unquote(s)
+	}
+	return "", fmt.Errorf("expected a quoted string, got %s", s)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testInfluxConfigs = `[default]
+  url = "http://localhost:8086"
+  token = "local-token"
+  org = "my-org"
+  active = true
+
+[eu-central]
+  url = "https://eu-central-1-1.aws.cloud2.influxdata.com"
+  token = "cloud-token\"quoted\""
+  org = 'cloud-org'
+  active = false
+  previous = true
+
+# 
+# [us-west]
+#   url = "https://us-west-2-1.aws.cloud2.influxdata.com"
+#   token = "XXX"
+#   org = ""
+`
+
+func TestParseInfluxConfigs(t *testing.T) {
+	configs, err := parseInfluxConfigs(strings.NewReader(testInfluxConfigs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("expected 2 profiles, got %d: %v", len(configs), configs)
+	}
+	want := influxConfig{Name: "eu-central", URL: "https://eu-central-1-1.aws.cloud2.influxdata.com", Token: `cloud-token"quoted"`, Org: "cloud-org"}
+	if configs[1] != want {
+		t.Errorf("expected %+v, got %+v", want, configs[1])
+	}
+
+	for _, invalid := range []string{
+		`url = "http://localhost:8086"`,
+		"[default\n",
+		"[default]\n  url = http://localhost:8086",
+		"[default]\n  active = yes",
+	} {
+		if _, err := parseInfluxConfigs(strings.NewReader(invalid)); err == nil {
+			t.Errorf("expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestReadInfluxConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs")
+	if err := ioutil.WriteFile(path, []byte(testInfluxConfigs), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := readInfluxConfig(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Name != "default" || c.Token != "local-token" {
+		t.Errorf("expected the active profile, got %+v", c)
+	}
+
+	c, err = readInfluxConfig(path, "eu-central")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Token != `cloud-token"quoted"` {
+		t.Errorf("expected the eu-central profile, got %+v", c)
+	}
+
+	if _, err := readInfluxConfig(path, "us-west"); err == nil {
+		t.Errorf("expected an error for a commented out profile")
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Line protocol elements, which each have their own escaping rules, see
+// https://docs.influxdata.com/influxdb/v2.0/reference/syntax/line-protocol/#special-characters
+const (
+	lineProtocolMeasurement = "measurement"
+	lineProtocolTagKey      = "tag_key"
+	lineProtocolTagValue    = "tag_value"
+	lineProtocolFieldKey    = "field_key"
+	lineProtocolFieldString = "field_string"
+)
+
+var lineProtocolElements = []string{
+	lineProtocolMeasurement,
+	lineProtocolTagKey,
+	lineProtocolTagValue,
+	lineProtocolFieldKey,
+	lineProtocolFieldString,
+}
+
+// Backslashes are escaped too, otherwise a trailing one escapes the delimiter that follows.
+var (
+	measurementEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, ` `, `\ `)
+	keyEscaper         = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `=`, `\=`, ` `, `\ `)
+	fieldStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// lineProtocolEscape escapes a user supplied string for use as the given line protocol
+// element, so it can't corrupt the point it is written in. Field strings are returned
+// quoted. Newlines are only allowed in field strings, since nothing else can escape them.
+func lineProtocolEscape(element string, value string) (string, error) {
+	if element != lineProtocolFieldString && strings.ContainsAny(value, "\r\n") {
+		return "", fmt.Errorf("a %s can't contain a newline", strings.Replace(element, "_", " ", -1))
+	}
+
+	switch element {
+	case lineProtocolMeasurement:
+		if value == "" {
+			return "", fmt.Errorf("a measurement can't be empty")
+		}
+		return measurementEscaper.Replace(value), nil
+	case lineProtocolTagKey, lineProtocolTagValue, lineProtocolFieldKey:
+		if value == "" {
+			return "", fmt.Errorf("a %s can't be empty", strings.Replace(element, "_", " ", -1))
+		}
+		return keyEscaper.Replace(value), nil
+	case lineProtocolFieldString:
+		return `"` + fieldStringEscaper.Replace(value) + `"`, nil
+	default:
+		return "", fmt.Errorf("unknown line protocol element %q, expected one of %v", element, lineProtocolElements)
+	}
+}
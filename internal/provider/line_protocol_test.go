@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestLineProtocolEscape(t *testing.T) {
+	cases := []struct {
+		element  string
+		value    string
+		expected string
+		err      bool
+	}{
+		{lineProtocolMeasurement, `my measurement,a=b`, `my\ measurement\,a=b`, false},
+		{lineProtocolTagKey, `host name`, `host\ name`, false},
+		{lineProtocolTagValue, `a=b,c d`, `a\=b\,c\ d`, false},
+		{lineProtocolFieldKey, `value=1`, `value\=1`, false},
+		{lineProtocolMeasurement, `cpu\`, `cpu\\`, false},
+		{lineProtocolTagKey, `host\`, `host\\`, false},
+		{lineProtocolTagValue, `C:\`, `C:\\`, false},
+		{lineProtocolFieldKey, `value\`, `value\\`, false},
+		{lineProtocolTagValue, `a\,b\ c`, `a\\\,b\\\ c`, false},
+		{lineProtocolFieldString, `say "hi" \o/`, `"say \"hi\" \\o/"`, false},
+		{lineProtocolFieldString, "two\nlines", "\"two\nlines\"", false},
+		{lineProtocolFieldString, ``, `""`, false},
+		{lineProtocolTagValue, "two\nlines", ``, true},
+		{lineProtocolMeasurement, ``, ``, true},
+		{lineProtocolTagValue, ``, ``, true},
+		{"timestamp", `1`, ``, true},
+	}
+
+	for _, c := range cases {
+		got, err := lineProtocolEscape(c.element, c.value)
+		if c.err {
+			if err == nil {
+				t.Errorf("expected an error escaping %q as a %s, got %q", c.value, c.element, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error escaping %q as a %s: %v", c.value, c.element, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("escaping %q as a %s: expected %q, got %q", c.value, c.element, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// logFields identify the operation a log line belongs to, so the lifecycle of a single
+// resource can be grepped out of a trace log with e.g. `resource_id=<id>`.
+type logFields struct {
+	resourceType string
+	action       string
+	orgID        string
+	// id is read when logging, since it is only known once a create succeeded
+	id func() string
+}
+
+func (f *logFields) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "resource_type=%s action=%s", f.resourceType, f.action)
+	if id := f.id(); id != "" {
+		fmt.Fprintf(&sb, " resource_id=%s", id)
+	}
+	if f.orgID != "" {
+		fmt.Fprintf(&sb, " org_id=%s", f.orgID)
+	}
+	return sb.String()
+}
+
+type logFieldsKey struct{}
+
+// logFieldsSuffix returns the log fields of the operation ctx belongs to, prefixed by a
+// space, or "" outside of an operation.
+func logFieldsSuffix(ctx context.Context) string {
+	if f, ok := ctx.Value(logFieldsKey{}).(*logFields); ok {
+		return " " + f.String()
+	}
+	return ""
+}
+
+// logOperations wraps the CRUD functions of every resource & the read function of every data
+// source so that their start & end, and every API request they make, are logged with the
+// log fields of the operation.
+func logOperations(resources map[string]*schema.Resource, dataSources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(withLogFields(name, "create", r.CreateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(withLogFields(name, "read", r.ReadContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(withLogFields(name, "update", r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(withLogFields(name, "delete", r.DeleteContext))
+		}
+	}
+	for name, r := range dataSources {
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(withLogFields("data."+name, "read", r.ReadContext))
+		}
+	}
+}
+
+func withLogFields(resourceType string, action string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// a read nested in a create or update keeps the fields of the outer operation
+		if _, ok := ctx.Value(logFieldsKey{}).(*logFields); ok {
+			return fn(ctx, d, meta)
+		}
+
+		fields := &logFields{
+			resourceType: resourceType,
+			action:       action,
+			id:           d.Id,
+		}
+		if v, ok := d.GetOk("org_id"); ok {
+			if orgID, ok := v.(string); ok {
+				fields.orgID = orgID
+			}
+		}
+		ctx = context.WithValue(ctx, logFieldsKey{}, fields)
+
+		log.Printf("[DEBUG] Starting operation %s", fields)
+		diags := fn(ctx, d, meta)
+		if diags.HasError() {
+			log.Printf("[DEBUG] Failed operation %s", fields)
+		} else {
+			log.Printf("[DEBUG] Finished operation %s", fields)
+		}
+		return diags
+	}
+}
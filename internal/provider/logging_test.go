@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLogOperations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"0000000000000002"}`))
+	}))
+	t.Cleanup(srv.Close)
+	api := newAPIClient(srv.URL, "token")
+
+	var buf bytes.Buffer
+	out := log.Writer()
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(out) })
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"org_id": {Type: schema.TypeString, Optional: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			var created struct {
+				ID string `json:"id"`
+			}
+			if err := api.doJSON(ctx, http.MethodPost, "/api/v2/things", nil, nil, &created); err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(created.ID)
+			return nil
+		},
+	}
+	logOperations(map[string]*schema.Resource{"influxdb2_thing": r}, nil)
+
+	d := r.TestResourceData()
+	d.Set("org_id", "0000000000000001")
+	if diags := r.CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := []string{
+		"[DEBUG] Starting operation resource_type=influxdb2_thing action=create org_id=0000000000000001",
+		"[DEBUG] POST /api/v2/things: 200 resource_type=influxdb2_thing action=create org_id=0000000000000001",
+		"[DEBUG] Finished operation resource_type=influxdb2_thing action=create resource_id=0000000000000002 org_id=0000000000000001",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d log lines, got:\n%s", len(expected), buf.String())
+	}
+	for i, l := range lines {
+		if !strings.HasSuffix(l, expected[i]) {
+			t.Errorf("expected log line %d to end with %q, got %q", i, expected[i], l)
+		}
+	}
+}
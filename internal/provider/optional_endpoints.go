@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// optionalEndpoint is an API that not every InfluxDB2 server has: it needs a newer release or
+// InfluxDB Cloud, or it can be disabled by the server configuration.
+type optionalEndpoint struct {
+	name        string
+	requirement string
+	// probe returns the path & query of a request that only fails when the API is missing.
+	probe func(d *schema.ResourceData) (string, url.Values)
+	// parent returns the path of the Organization or Bucket the probe is scoped to, if any.
+	// The probe of a deleted parent fails too, and its resources were deleted along with it.
+	parent func(d *schema.ResourceData) string
+}
+
+func orgScopedProbe(path string) func(d *schema.ResourceData) (string, url.Values) {
+	return func(d *schema.ResourceData) (string, url.Values) {
+		return path, url.Values{"orgID": []string{d.Get("org_id").(string)}}
+	}
+}
+
+func orgParent(d *schema.ResourceData) string {
+	return "/api/v2/orgs/" + d.Get("org_id").(string)
+}
+
+// optionalEndpoints are the optional APIs used by each resource.
+var optionalEndpoints = map[string]optionalEndpoint{
+	"influxdb2_annotation_stream": {
+		name:        "annotations API",
+		requirement: "InfluxDB2 OSS 2.1 or later, or InfluxDB Cloud",
+		probe:       orgScopedProbe("/api/v2/streams"),
+		parent:      orgParent,
+	},
+	"influxdb2_measurement_schema": {
+		name:        "measurement schemas API",
+		requirement: "InfluxDB Cloud",
+		probe: func(d *schema.ResourceData) (string, url.Values) {
+			return measurementSchemasPath(d.Get("bucket_id").(string)), nil
+		},
+		parent: func(d *schema.ResourceData) string {
+			return "/api/v2/buckets/" + d.Get("bucket_id").(string)
+		},
+	},
+	"influxdb2_org_invite": {
+		name:        "invites API",
+		requirement: "InfluxDB Cloud",
+		probe: func(d *schema.ResourceData) (string, url.Values) {
+			return invitesPath(d.Get("org_id").(string)), nil
+		},
+		parent: orgParent,
+	},
+	"influxdb2_remote": {
+		name:        "remotes API",
+		requirement: "InfluxDB2 OSS 2.1 or later",
+		probe:       orgScopedProbe("/api/v2/remotes"),
+		parent:      orgParent,
+	},
+	"influxdb2_script": {
+		name:        "invokable scripts API",
+		requirement: "InfluxDB Cloud",
+		probe: func(d *schema.ResourceData) (string, url.Values) {
+			return "/api/v2/scripts", nil
+		},
+	},
+	"influxdb2_source": {
+		name:        "sources API",
+		requirement: "InfluxDB2 OSS",
+		probe: func(d *schema.ResourceData) (string, url.Values) {
+			return "/api/v2/sources", nil
+		},
+	},
+	"influxdb2_v1_authorization": {
+		name:        "legacy authorizations API",
+		requirement: "InfluxDB2 OSS",
+		probe: func(d *schema.ResourceData) (string, url.Values) {
+			return v1AuthorizationsPath, nil
+		},
+	},
+}
+
+// unavailable returns an error describing why the API can't be used, or nil when it is
+// available. Only a missing or forbidden API is reported; other errors, and a missing
+// parent, are left to the operation that failed.
+func (e optionalEndpoint) unavailable(ctx context.Context, api *apiClient, d *schema.ResourceData) error {
+	path, query := e.probe(d)
+
+	var apiErr *apiError
+	if err := api.doJSON(ctx, http.MethodGet, path, query, nil, nil); !errors.As(err, &apiErr) {
+		return nil
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound:
+		if e.parent != nil && isNotFound(api.doJSON(ctx, http.MethodGet, e.parent(d), nil, nil, nil)) {
+			return nil
+		}
+		return fmt.Errorf("the %s isn't available on this server: it requires %s, and can be disabled by the server configuration", e.name, e.requirement)
+	case http.StatusForbidden:
+		return fmt.Errorf("the %s is forbidden on this server: the token lacks permission, or the API is disabled by the server configuration", e.name)
+	}
+	return nil
+}
+
+// degradeOptionalEndpoints wraps the CRUD functions of the resources using an optional API.
+// When an operation fails, or a read finds the resource missing, the API is probed: if it is
+// unavailable, a diagnostic saying so is appended to the ones of the operation, and a
+// resource that a read found missing is kept in state rather than planned for re-creation. A resource whose
+// Organization or Bucket is missing too was deleted along with it, and is removed.
+func degradeOptionalEndpoints(resources map[string]*schema.Resource) {
+	for name, e := range optionalEndpoints {
+		r, ok := resources[name]
+		if !ok {
+			continue
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(withOptionalEndpoint(name, e, r.CreateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(withOptionalEndpoint(name, e, r.ReadContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(withOptionalEndpoint(name, e, r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(withOptionalEndpoint(name, e, r.DeleteContext))
+		}
+	}
+}
+
+func withOptionalEndpoint(resourceType string, e optionalEndpoint, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		id := d.Id()
+		diags := fn(ctx, d, meta)
+
+		removed := id != "" && d.Id() == ""
+		md, ok := meta.(*metaData)
+		if !ok || (!diags.HasError() && !removed) {
+			return diags
+		}
+
+		err := e.unavailable(ctx, md.api, d)
+		if err == nil {
+			return diags
+		}
+
+		// the diagnostics of the operation are kept, as they tell what failed
+		detail := fmt.Sprintf("%s can't be managed without it.", resourceType)
+		if removed {
+			log.Printf("[WARN] %s (%s) not found because the %s is unavailable, keeping it in state", resourceType, id, e.name)
+			d.SetId(id)
+			detail = fmt.Sprintf("%s (%s) is kept in state.", resourceType, id)
+		}
+
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   detail,
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOptionalEndpointRead(t *testing.T) {
+	cases := []struct {
+		name       string
+		listStatus int
+		orgStatus  int
+		keptID     string
+		err        string
+	}{
+		{"remote deleted", http.StatusOK, http.StatusOK, "", ""},
+		{"org deleted", http.StatusNotFound, http.StatusNotFound, "", ""},
+		{"API missing", http.StatusNotFound, http.StatusOK, "0000000000000002", "remotes API isn't available on this server"},
+		{"API disabled", http.StatusForbidden, http.StatusOK, "0000000000000002", "remotes API is forbidden on this server"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v2/remotes/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"code":"not found","message":"not found"}`))
+			})
+			mux.HandleFunc("/api/v2/remotes", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.listStatus)
+				w.Write([]byte(`{"remotes":[]}`))
+			})
+			mux.HandleFunc("/api/v2/orgs/0000000000000001", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.orgStatus)
+				w.Write([]byte(`{"id":"0000000000000001","name":"my-org"}`))
+			})
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+
+			r := resourceRemote()
+			degradeOptionalEndpoints(map[string]*schema.Resource{"influxdb2_remote": r})
+
+			d := r.TestResourceData()
+			d.SetId("0000000000000002")
+			d.Set("org_id", "0000000000000001")
+
+			diags := r.ReadContext(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+			if d.Id() != c.keptID {
+				t.Errorf("expected ID %q, got %q", c.keptID, d.Id())
+			}
+			if c.err == "" && diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if c.err != "" && (!diags.HasError() || !strings.Contains(diags[0].Summary, c.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", c.err, diags)
+			}
+		})
+	}
+}
+
+func TestOptionalEndpointKeepsDiagnostics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"not found","message":"not found"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	failed := diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "warning", Detail: "warning detail"},
+		{Severity: diag.Error, Summary: "first error", Detail: "first detail"},
+		{Severity: diag.Error, Summary: "second error", Detail: "second detail"},
+	}
+	create := withOptionalEndpoint("influxdb2_script", optionalEndpoints["influxdb2_script"], func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return failed
+	})
+
+	diags := create(context.Background(), resourceScript().TestResourceData(), &metaData{api: newAPIClient(srv.URL, "token")})
+	if len(diags) != len(failed)+1 {
+		t.Fatalf("expected the %d diagnostics of the operation and the unavailable API, got: %v", len(failed), diags)
+	}
+	for i, kept := range failed {
+		if diags[i].Severity != kept.Severity || diags[i].Summary != kept.Summary || diags[i].Detail != kept.Detail {
+			t.Errorf("expected diagnostic %d to be kept as %v, got %v", i, kept, diags[i])
+		}
+	}
+	if last := diags[len(failed)]; last.Severity != diag.Error || !strings.Contains(last.Summary, "invokable scripts API isn't available") {
+		t.Errorf("expected the unavailable API to be reported last, got %v", last)
+	}
+}
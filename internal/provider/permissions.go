@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// permissionSchema is the schema of a single authorization permission.
+func permissionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Description:      "The permitted action, `read` or `write`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{string(domain.PermissionActionRead), string(domain.PermissionActionWrite)}, false),
+			},
+			"type": {
+				Description:      "The type of resource the permission applies to, e.g. `buckets`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"id": {
+				Description: "ID of the resource the permission applies to. When omitted, it applies to all resources of the type.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"org_id": {
+				Description: "ID of the Organization owning the resources the permission applies to. When omitted, it applies to resources of all Organizations.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// permissionsInputSchema is the schema for the permissions granted by an authorization. It is
+// a set, so that the order of the permissions in the configuration and the API responses
+// never shows up as a diff.
+func permissionsInputSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeSet,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Elem:        permissionSchema(),
+	}
+}
+
+// permissionsOutputSchema is the schema for the permissions of an authorization read by a
+// data source. The list is in canonical order, see sortPermissions.
+func permissionsOutputSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        permissionSchema(),
+	}
+}
+
+// sortPermissions sorts permissions by resource type, Organization ID, resource ID & action,
+// since the API returns them in an arbitrary order.
+func sortPermissions(permissions []domain.Permission) {
+	sort.SliceStable(permissions, func(i, j int) bool {
+		a, b := permissions[i], permissions[j]
+		if a.Resource.Type != b.Resource.Type {
+			return a.Resource.Type < b.Resource.Type
+		}
+		if stringValue(a.Resource.OrgID) != stringValue(b.Resource.OrgID) {
+			return stringValue(a.Resource.OrgID) < stringValue(b.Resource.OrgID)
+		}
+		if stringValue(a.Resource.Id) != stringValue(b.Resource.Id) {
+			return stringValue(a.Resource.Id) < stringValue(b.Resource.Id)
+		}
+		return a.Action < b.Action
+	})
+}
+
+// flattenPermissions converts permissions to their state representation, in canonical order.
+func flattenPermissions(permissions []domain.Permission) []interface{} {
+	sorted := make([]domain.Permission, len(permissions))
+	copy(sorted, permissions)
+	sortPermissions(sorted)
+
+	res := make([]interface{}, 0, len(sorted))
+	for _, p := range sorted {
+		res = append(res, map[string]interface{}{
+			"action": string(p.Action),
+			"type":   string(p.Resource.Type),
+			"id":     stringValue(p.Resource.Id),
+			"org_id": stringValue(p.Resource.OrgID),
+		})
+	}
+	return res
+}
+
+// expandPermissions converts the permissions from a configuration to API permissions, in
+// canonical order so requests are deterministic.
+func expandPermissions(v *schema.Set) []domain.Permission {
+	res := make([]domain.Permission, 0, v.Len())
+	for _, raw := range v.List() {
+		m := raw.(map[string]interface{})
+
+		p := domain.Permission{
+			Action:   domain.PermissionAction(m["action"].(string)),
+			Resource: domain.Resource{Type: domain.ResourceType(m["type"].(string))},
+		}
+		if id := m["id"].(string); id != "" {
+			p.Resource.Id = &id
+		}
+		if orgID := m["org_id"].(string); orgID != "" {
+			p.Resource.OrgID = &orgID
+		}
+		res = append(res, p)
+	}
+	sortPermissions(res)
+	return res
+}
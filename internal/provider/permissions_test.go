@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func testPermission(action domain.PermissionAction, resourceType string, orgID string, id string) domain.Permission {
+	p := domain.Permission{
+		Action:   action,
+		Resource: domain.Resource{Type: domain.ResourceType(resourceType)},
+	}
+	if orgID != "" {
+		p.Resource.OrgID = &orgID
+	}
+	if id != "" {
+		p.Resource.Id = &id
+	}
+	return p
+}
+
+func TestFlattenPermissionsIsCanonical(t *testing.T) {
+	permissions := []domain.Permission{
+		testPermission(domain.PermissionActionWrite, "buckets", "0000000000000001", "0000000000000003"),
+		testPermission(domain.PermissionActionRead, "orgs", "", ""),
+		testPermission(domain.PermissionActionRead, "buckets", "0000000000000001", "0000000000000003"),
+		testPermission(domain.PermissionActionRead, "buckets", "0000000000000001", "0000000000000002"),
+		testPermission(domain.PermissionActionRead, "buckets", "", ""),
+	}
+	reversed := make([]domain.Permission, len(permissions))
+	for i, p := range permissions {
+		reversed[len(permissions)-1-i] = p
+	}
+
+	flattened := flattenPermissions(permissions)
+	if !reflect.DeepEqual(flattened, flattenPermissions(reversed)) {
+		t.Fatal("expected the same state regardless of the API's order")
+	}
+
+	expected := []string{
+		"read buckets  ",
+		"read buckets 0000000000000001 0000000000000002",
+		"read buckets 0000000000000001 0000000000000003",
+		"write buckets 0000000000000001 0000000000000003",
+		"read orgs  ",
+	}
+	for i, raw := range flattened {
+		m := raw.(map[string]interface{})
+		got := m["action"].(string) + " " + m["type"].(string) + " " + m["org_id"].(string) + " " + m["id"].(string)
+		if got != expected[i] {
+			t.Errorf("permission %d: expected %q, got %q", i, expected[i], got)
+		}
+	}
+
+	// flattening must not reorder the caller's slice
+	if permissions[0].Action != domain.PermissionActionWrite {
+		t.Error("expected the input to be left untouched")
+	}
+}
+
+func TestExpandPermissions(t *testing.T) {
+	set := schema.NewSet(schema.HashResource(permissionSchema()), flattenPermissions([]domain.Permission{
+		testPermission(domain.PermissionActionWrite, "buckets", "0000000000000001", ""),
+		testPermission(domain.PermissionActionRead, "buckets", "0000000000000001", ""),
+	}))
+
+	expected := []domain.Permission{
+		testPermission(domain.PermissionActionRead, "buckets", "0000000000000001", ""),
+		testPermission(domain.PermissionActionWrite, "buckets", "0000000000000001", ""),
+	}
+	if got := expandPermissions(set); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
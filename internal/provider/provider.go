@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	influxdb2 "github.com/influxdata/influxdb-client-go"
 	"github.com/influxdata/influxdb-client-go/domain"
 )
 
@@ -29,27 +33,292 @@ func New(version string) func() *schema.Provider {
 		p := &schema.Provider{
 			Schema: map[string]*schema.Schema{
 				"host": {
-					Description: "The host url where influxDB2 lives. Can also be set using the `INFLUX_HOST` environment variable.",
+					Description: "The host url where influxDB2 lives. Can also be set using the `INFLUX_HOST` or `INFLUXDB2_URL` environment variables, or with `profile`.",
 					Type:        schema.TypeString,
-					Required:    true,
-					DefaultFunc: schema.EnvDefaultFunc("INFLUX_HOST", nil),
+					Optional:    true,
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{"INFLUX_HOST", "INFLUXDB2_URL"}, nil),
 				},
 				"token": {
-					Description: "An auth token that has the nesecary permissions to read-from and/or write-to InfluxDB2. Ideally this should be set using the `INFLUX_TOKEN` environment variable, so that the secret is not saved to source control.",
+					Description: "An auth token that has the nesecary permissions to read-from and/or write-to InfluxDB2. Ideally this should be set using the `INFLUX_TOKEN` or `INFLUXDB2_TOKEN` environment variables, so that the secret is not saved to source control. Either `token` or `token_file`, or `username` & `password`, must be set.",
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
-					DefaultFunc: schema.EnvDefaultFunc("INFLUX_TOKEN", nil),
+					DefaultFunc: schema.MultiEnvDefaultFunc([]string{"INFLUX_TOKEN", "INFLUXDB2_TOKEN"}, nil),
+				},
+				"token_file": {
+					Description:   "Path of a file with the auth token, read when the provider is configured, e.g. a token rendered by Vault Agent or mounted from a Kubernetes secret. Leading and trailing whitespace is ignored. Takes precedence over the `token` environment variables.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"token"},
+				},
+				"username": {
+					Description:  "Name of a user to authenticate as with a session, instead of `token`, e.g. when no long-lived token is available at plan time. Sessions are an InfluxDB2 OSS feature. Can also be set using the `INFLUX_USERNAME` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"password"},
+					DefaultFunc:  schema.EnvDefaultFunc("INFLUX_USERNAME", nil),
+				},
+				"password": {
+					Description:  "Password of `username`. Ideally this should be set using the `INFLUX_PASSWORD` environment variable, so that the secret is not saved to source control.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{"username"},
+					DefaultFunc:  schema.EnvDefaultFunc("INFLUX_PASSWORD", nil),
+				},
+				"org": {
+					Description:   "Name of the default Organization of the resources and data sources that don't set `org_id`. Can also be set with the `INFLUX_ORG` or `INFLUXDB2_ORG` environment variable.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"INFLUX_ORG", "INFLUXDB2_ORG"}, nil),
+					ConflictsWith: []string{"org_id"},
+				},
+				"org_id": {
+					Description: "ID of the default Organization of the resources and data sources that don't set `org_id`, takes precedence over `org`. Can also be set with the `INFLUX_ORG_ID` environment variable.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("INFLUX_ORG_ID", nil),
+				},
+				"profile": {
+					Description: "Name of an influx CLI profile, as created by `influx config create`, to read the `host`, `token` and `org` that aren't otherwise set from. The arguments and their environment variables take precedence, like for the CLI. Can also be set with the `INFLUX_ACTIVE_CONFIG` environment variable. The active profile is used when only `config_file` is set.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("INFLUX_ACTIVE_CONFIG", nil),
+				},
+				"config_file": {
+					Description: "Path of the influx CLI configs file of `profile`. Can also be set with the `INFLUX_CONFIGS_PATH` environment variable. Defaults to `~/.influxdbv2/configs`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("INFLUX_CONFIGS_PATH", nil),
+				},
+				"insecure_skip_verify": {
+					Description: "Whether to skip the verification of the TLS certificate of `host`, e.g. for a lab instance with a self-signed certificate. This makes the connection vulnerable to man-in-the-middle attacks. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+				"ca_cert_pem": {
+					Description:   "A PEM encoded CA certificate to trust, in addition to the system ones, when verifying the TLS certificate of `host`, e.g. for an internal CA.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_file"},
+				},
+				"ca_cert_file": {
+					Description:   "Path of a file with a PEM encoded CA certificate to trust, like `ca_cert_pem`.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_pem"},
+				},
+				"client_cert_pem": {
+					Description:   "A PEM encoded client certificate to authenticate with to `host`, for instances fronted by a load balancer enforcing mutual TLS. Requires `client_key_pem` or `client_key_file`.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_cert_file"},
+				},
+				"client_cert_file": {
+					Description:   "Path of a file with a PEM encoded client certificate, like `client_cert_pem`.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_cert_pem"},
+				},
+				"client_key_pem": {
+					Description:   "The PEM encoded private key of the client certificate. Ideally this should be set with `client_key_file`, so that the secret is not saved to source control.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"client_key_file"},
+				},
+				"client_key_file": {
+					Description:   "Path of a file with the PEM encoded private key of the client certificate, like `client_key_pem`.",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_key_pem"},
+				},
+				"proxy_url": {
+					Description:      "URL of the HTTP proxy to reach `host` through, e.g. `http://proxy.example.com:3128`. When unset, the `HTTP_PROXY`, `HTTPS_PROXY` & `NO_PROXY` environment variables are used.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateURL,
+				},
+				"headers": {
+					Description: "Extra HTTP headers sent with every request to `host`, e.g. `CF-Access-Client-Id` & `CF-Access-Client-Secret` for an instance behind Cloudflare Access, or the headers expected by another authenticating gateway. The `Authorization` header can't be set.",
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"user_agent": {
+					Description: "User-Agent header of the requests to `host`, which InfluxDB2 records in its access logs. Defaults to `terraform-provider-influxdb2/<version>`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"http_timeout": {
+					Description:      "Timeout of each request to `host`, as a duration, e.g. `2m`, for large template applies or slow instances. Defaults to `20s`.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateDuration,
+				},
+				"max_retries": {
+					Description:      "Maximum number of times a request is retried when InfluxDB2 answers with a transient error: 429 Too Many Requests, 503 Service Unavailable, and 502 & 504 for requests that are safe to repeat. InfluxDB Cloud returns these when an Organization exceeds its rate limits. `0` disables retries. Defaults to `4`.",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          defaultMaxRetries,
+					ValidateDiagFunc: validateIntBetween(0, 20),
+				},
+				"max_backoff": {
+					Description:      "Maximum wait between two retries, as a duration, e.g. `1m`. The wait starts at `1s` and doubles on every retry, unless the response has a `Retry-After` header. Defaults to `30s`.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateDuration,
+				},
+				"max_requests_per_second": {
+					Description:      "Maximum number of requests sent to `host` a second, across all resources and data sources, e.g. to stay below the rate limits of an InfluxDB Cloud plan when a `for_each` creates many buckets or tokens. Unlimited when unset.",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					ValidateDiagFunc: validateIntBetween(1, 10000),
+				},
+				"skip_health_check": {
+					Description: "Whether to skip checking that `host` is ready and healthy when the provider is configured, e.g. when a gateway only exposes the `/api/v2` paths, or to plan without network access to `host`. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+				"validate_ids": {
+					Description: "Whether to check at plan time that the `*_id` arguments of resources, when set to literal values, look like InfluxDB2 IDs (16 hex characters). This catches names used in place of IDs before apply. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+				"check_quotas": {
+					Description: "Whether to check the quotas of InfluxDB Cloud Organizations at plan time, failing the plan when creating buckets, tasks or dashboards would exceed them. Defaults to `false`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+				"cardinality_warning_percent": {
+					Description:      "Percentage of the cardinality quota of an InfluxDB Cloud Organization above which reading a bucket scoped resource, e.g. `influxdb2_measurement_schema`, warns that its bucket nears the quota. Disabled when unset.",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					ValidateDiagFunc: validateIntBetween(1, 100),
+				},
+				"audit_log_file": {
+					Description: "Path of a local file that a JSON line is appended to for every create, update & delete made by the provider, recording the resource type, action, ID, actor (the InfluxDB2 user owning `token`), duration and outcome. Disabled when unset.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"cloud_dedicated": {
+					Description: "Connection to the Management API of an InfluxDB Cloud Dedicated cluster, used by the `influxdb2_database` resource & data source.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"account_id": {
+								Description: "ID of the Cloud Dedicated account.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"cluster_id": {
+								Description: "ID of the Cloud Dedicated cluster.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"management_token": {
+								Description: "A management token of the cluster. Ideally this should be set using the `INFLUX_MANAGEMENT_TOKEN` environment variable, so that the secret is not saved to source control.",
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								DefaultFunc: schema.EnvDefaultFunc("INFLUX_MANAGEMENT_TOKEN", nil),
+							},
+							"management_url": {
+								Description: "URL of the Management API.",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "https://console.influxdata.com",
+							},
+						},
+					},
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"influxdb2_organization": dataSourceOrganization(),
+				"influxdb2_all_buckets":                  dataSourceAllBuckets(),
+				"influxdb2_authorizations":               dataSourceAuthorizations(),
+				"influxdb2_bucket_schemas":               dataSourceBucketSchemas(),
+				"influxdb2_buckets":                      dataSourceBuckets(),
+				"influxdb2_check":                        dataSourceCheck(),
+				"influxdb2_check_statuses":               dataSourceCheckStatuses(),
+				"influxdb2_checks":                       dataSourceChecks(),
+				"influxdb2_dashboard":                    dataSourceDashboard(),
+				"influxdb2_dashboards":                   dataSourceDashboards(),
+				"influxdb2_database":                     dataSourceDatabase(),
+				"influxdb2_dbrp":                         dataSourceDBRP(),
+				"influxdb2_dbrps":                        dataSourceDBRPs(),
+				"influxdb2_field_keys":                   dataSourceFieldKeys(),
+				"influxdb2_flags":                        dataSourceFlags(),
+				"influxdb2_flux_query":                   dataSourceFluxQuery(),
+				"influxdb2_health":                       dataSourceHealth(),
+				"influxdb2_label":                        dataSourceLabel(),
+				"influxdb2_labels":                       dataSourceLabels(),
+				"influxdb2_me":                           dataSourceMe(),
+				"influxdb2_measurements":                 dataSourceMeasurements(),
+				"influxdb2_notification_endpoint":        dataSourceNotificationEndpoint(),
+				"influxdb2_notification_endpoint_health": dataSourceNotificationEndpointHealth(),
+				"influxdb2_notification_endpoints":       dataSourceNotificationEndpoints(),
+				"influxdb2_notification_history":         dataSourceNotificationHistory(),
+				"influxdb2_notification_rules":           dataSourceNotificationRules(),
+				"influxdb2_org_limits":                   dataSourceOrgLimits(),
+				"influxdb2_org_members":                  dataSourceOrgMembers(),
+				"influxdb2_organization":                 dataSourceOrganization(),
+				"influxdb2_organizations":                dataSourceOrganizations(),
+				"influxdb2_query_export":                 dataSourceQueryExport(),
+				"influxdb2_ready":                        dataSourceReady(),
+				"influxdb2_scripts":                      dataSourceScripts(),
+				"influxdb2_server_config":                dataSourceServerConfig(),
+				"influxdb2_setup_allowed":                dataSourceSetupAllowed(),
+				"influxdb2_stacks":                       dataSourceStacks(),
+				"influxdb2_tag_keys":                     dataSourceTagKeys(),
+				"influxdb2_tag_values":                   dataSourceTagValues(),
+				"influxdb2_task_run_logs":                dataSourceTaskRunLogs(),
+				"influxdb2_tasks":                        dataSourceTasks(),
+				"influxdb2_telegraf_config":              dataSourceTelegrafConfig(),
+				"influxdb2_telegraf_configs":             dataSourceTelegrafConfigs(),
+				"influxdb2_template_export":              dataSourceTemplateExport(),
+				"influxdb2_user":                         dataSourceUser(),
+				"influxdb2_users":                        dataSourceUsers(),
+				"influxdb2_variables":                    dataSourceVariables(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"influxdb2_organization": resourceOrganization(),
+				"influxdb2_annotation_stream":  resourceAnnotationStream(),
+				"influxdb2_backup":             resourceBackup(),
+				"influxdb2_bucket_member":      resourceBucketMember(),
+				"influxdb2_dashboard":          resourceDashboard(),
+				"influxdb2_database":           resourceDatabase(),
+				"influxdb2_database_token":     resourceDatabaseToken(),
+				"influxdb2_dbrp":               resourceDBRP(),
+				"influxdb2_delete":             resourceDelete(),
+				"influxdb2_environment":        resourceEnvironment(),
+				"influxdb2_measurement_schema": resourceMeasurementSchema(),
+				"influxdb2_org_invite":         resourceOrgInvite(),
+				"influxdb2_org_owner":          resourceOrgOwner(),
+				"influxdb2_organization":       resourceOrganization(),
+				"influxdb2_remote":             resourceRemote(),
+				"influxdb2_restore":            resourceRestore(),
+				"influxdb2_rotating_token":     resourceRotatingToken(),
+				"influxdb2_script":             resourceScript(),
+				"influxdb2_secret":             resourceSecret(),
+				"influxdb2_setup":              resourceSetup(),
+				"influxdb2_source":             resourceSource(),
+				"influxdb2_stack":              resourceStack(),
+				"influxdb2_task_run":           resourceTaskRun(),
+				"influxdb2_template_apply":     resourceTemplateApply(),
+				"influxdb2_v1_authorization":   resourceV1Authorization(),
+				"influxdb2_write":              resourceWrite(),
 			},
 		}
 
+		auditResources(p.ResourcesMap)
+		validateResourceIDs(p.ResourcesMap)
+		degradeOptionalEndpoints(p.ResourcesMap)
+		logOperations(p.ResourcesMap, p.DataSourcesMap)
+
 		p.ConfigureContextFunc = providerConfigure(version, p)
 
 		return p
@@ -60,71 +329,232 @@ type metaData struct {
 	// Add whatever fields, client or connection info, etc. here
 	// you would need to setup to communicate with the upstream
 	// API.
-	client influxdb2.Client
+	api *apiClient
+	// audit is nil unless audit_log_file is set
+	audit *auditLogger
+	// validateIDs enables the plan time check of ID arguments, see validateResourceIDs
+	validateIDs bool
+	// checkQuotas enables the plan time quota checks, see checkQuota
+	checkQuotas bool
+	// cardinalityWarningPercent enables the bucket cardinality warnings, see checkBucketCardinality
+	cardinalityWarningPercent int
+	// dedicated is nil unless the cloud_dedicated block is set
+	dedicated *dedicatedClient
+	// orgID is the default org_id of the resources & data sources, see setDefaultOrgID
+	orgID string
 }
 
 func providerConfigure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		host := d.Get("host").(string)
 		token := d.Get("token").(string)
+		username := d.Get("username").(string)
 
 		// Warning or errors can be collected in a slice type
 		var diags diag.Diagnostics
 
-		if host == "" || token == "" {
+		// like for the influx CLI, the profile only fills in what isn't set otherwise
+		var profileOrg string
+		if profile, path := d.Get("profile").(string), d.Get("config_file").(string); profile != "" || path != "" {
+			c, err := influxProfile(profile, path)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to read the influx CLI profile",
+					Detail:   err.Error(),
+				})
+				return nil, diags
+			}
+			if host == "" {
+				host = c.URL
+			}
+			if token == "" && username == "" {
+				token = c.Token
+			}
+			profileOrg = c.Org
+		}
+
+		if v, ok := d.GetOk("token_file"); ok {
+			b, err := ioutil.ReadFile(v.(string))
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to create InfluxDB2 client",
+					Detail:   fmt.Sprintf("Unable to read token_file: %v", err),
+				})
+				return nil, diags
+			}
+			token = strings.TrimSpace(string(b))
+			if token == "" {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to create InfluxDB2 client",
+					Detail:   fmt.Sprintf("The token_file (%s) is empty", v.(string)),
+				})
+				return nil, diags
+			}
+		}
+
+		if host == "" || (token == "" && username == "") {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Unable to create InfluxDB2 client",
-				Detail:   "Unable to auth authenticated InfluxDB2 client",
+				Detail:   "Unable to auth authenticated InfluxDB2 client: `host`, and either `token`, `token_file` or `username` & `password`, must be set, directly or with `profile`",
 			})
 			return nil, diags
 		}
-
-		options := influxdb2.DefaultOptions()
-		//0 error, 1 - warning, 2 - info, 3 - debug
-		options.SetLogLevel(3)
-
-		// userAgent := p.UserAgent("terraform-provider-influxdb2", version)
-		// TODO: options.UserAgent = userAgent
-
-		client := influxdb2.NewClientWithOptions(host, token, options)
-
-		ok, err := client.Ready(ctx)
-		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-			client.Close()
-			return nil, diags
-		}
-		if !ok {
+		if token != "" && username != "" {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "InfluxDB2 Server is not ready",
-				Detail:   "InfluxDB2 Server is not ready",
+				Summary:  "Unable to create InfluxDB2 client",
+				Detail:   "Only one of `token` and `username` can be set, including with environment variables",
 			})
-			client.Close()
 			return nil, diags
 		}
 
-		check, err := client.Health(ctx)
-		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-			client.Close()
-			return nil, diags
+		api := newAPIClient(host, token)
+		if username != "" {
+			api = newSessionAPIClient(host, username, d.Get("password").(string))
 		}
-		if check.Status != domain.HealthCheckStatusPass {
+		transport, err := newTransport(d)
+		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "InfluxDB2 Health is not passing",
-				Detail:   "InfluxDB2 Health is not passing",
+				Summary:  "Unable to create InfluxDB2 client",
+				Detail:   err.Error(),
 			})
-			client.Close()
 			return nil, diags
 		}
+		api.httpClient.Transport = transport
+		if v, ok := d.GetOk("http_timeout"); ok {
+			// validated by validateDuration
+			api.httpClient.Timeout, _ = time.ParseDuration(v.(string))
+		}
+		api.maxRetries = d.Get("max_retries").(int)
+		if v, ok := d.GetOk("max_backoff"); ok {
+			// validated by validateDuration
+			api.maxBackoff, _ = time.ParseDuration(v.(string))
+		}
+		api.userAgent = "terraform-provider-influxdb2/" + version
+		if v, ok := d.GetOk("user_agent"); ok {
+			api.userAgent = v.(string)
+		}
+		if v, ok := d.GetOk("headers"); ok {
+			api.headers = map[string]string{}
+			for k, h := range v.(map[string]interface{}) {
+				if http.CanonicalHeaderKey(k) == "Authorization" {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Unable to create InfluxDB2 client",
+						Detail:   "`headers` can't set the Authorization header, which is set from `token`, or `username` & `password`",
+					})
+					return nil, diags
+				}
+				api.headers[k] = h.(string)
+			}
+		}
+		if v, ok := d.GetOk("max_requests_per_second"); ok {
+			api.limiter = newRateLimiter(v.(int))
+		}
+
+		if !d.Get("skip_health_check").(bool) {
+			diags = append(diags, checkServer(ctx, api)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		if username != "" {
+			if err := api.signin(ctx); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to sign in to InfluxDB2",
+					Detail:   fmt.Sprintf("Unable to start a session for user (%s): %v", username, err),
+				})
+				return nil, diags
+			}
+		}
+
+		orgID := d.Get("org_id").(string)
+		org := d.Get("org").(string)
+		if org == "" {
+			org = profileOrg
+		}
+		if orgID == "" && org != "" {
+			o, err := api.findOrganizationByName(ctx, org)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to find the default Organization",
+					Detail:   fmt.Sprintf("Unable to find the Organization (%s) set by `org`: %v", org, err),
+				})
+				return nil, diags
+			}
+			orgID = *o.Id
+		}
 
 		md := &metaData{
-			client: client,
+			api:                       api,
+			orgID:                     orgID,
+			validateIDs:               d.Get("validate_ids").(bool),
+			checkQuotas:               d.Get("check_quotas").(bool),
+			cardinalityWarningPercent: d.Get("cardinality_warning_percent").(int),
+		}
+
+		if v, ok := d.GetOk("cloud_dedicated"); ok {
+			c := v.([]interface{})[0].(map[string]interface{})
+			md.dedicated = newDedicatedClient(c["management_url"].(string), c["management_token"].(string), c["account_id"].(string), c["cluster_id"].(string))
+			md.dedicated.api.userAgent = api.userAgent
+		}
+
+		if v, ok := d.GetOk("audit_log_file"); ok {
+			md.audit = newAuditLogger(v.(string), md.api)
 		}
 
 		return md, nil
 	}
 }
+
+// checkServer checks that the server is ready and healthy, so that a wrong host or a server
+// that is down fails the configuration of the provider with a clear diagnostic, rather than
+// every resource with its own error.
+func checkServer(ctx context.Context, api *apiClient) diag.Diagnostics {
+	const hint = " Set `skip_health_check` to skip this check."
+
+	ready, err := api.ready(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to InfluxDB2",
+			Detail:   fmt.Sprintf("Unable to check that the InfluxDB2 server at (%s) is ready: %v.%s", api.host, err, hint),
+		}}
+	}
+	if ready.Status == nil || *ready.Status != domain.ReadyStatusReady {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "InfluxDB2 Server is not ready",
+			Detail:   fmt.Sprintf("The InfluxDB2 server at (%s) is not ready, e.g. it is still starting.%s", api.host, hint),
+		}}
+	}
+
+	check, err := api.health(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to connect to InfluxDB2",
+			Detail:   fmt.Sprintf("Unable to check the health of the InfluxDB2 server at (%s): %v.%s", api.host, err, hint),
+		}}
+	}
+	if check.Status != domain.HealthCheckStatusPass {
+		message := ""
+		if check.Message != nil {
+			message = ": " + *check.Message
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "InfluxDB2 Health is not passing",
+			Detail:   fmt.Sprintf("The health of the InfluxDB2 server at (%s) is %s%s.%s", api.host, check.Status, message, hint),
+		}}
+	}
+	return nil
+}
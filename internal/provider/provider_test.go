@@ -1,11 +1,24 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	influxdb2 "github.com/influxdata/influxdb-client-go"
+	"github.com/influxdata/influxdb-client-go/domain"
 )
 
 // How to run the acceptance tests for this provider:
@@ -35,13 +48,354 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// These match the docker-compose.yaml setup of the test InfluxDB server
+const (
+	testHost          = "http://localhost:8086"
+	testToken         = "oops_this_is_committed_to_source_control"
+	testInitialOrg    = "initial-org"
+	testInitialBucket = "initial-bucket"
+	testUsername      = "admin"
+	testPassword      = "super-secret"
+)
+
+// testServerMux returns a mux answering the configure time checks of the provider like a
+// ready & healthy server, for tests of the provider configuration against a fake server.
+func testServerMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready","started":"2021-06-01T10:00:00Z","up":"1h"}`))
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"influxdb","message":"ready for queries and writes","status":"pass","checks":[]}`))
+	})
+	return mux
+}
+
+// testProviderMeta configures the provider with the given arguments and returns its meta
+// data, failing the test on any error diagnostic.
+func testProviderMeta(t *testing.T, config map[string]interface{}) *metaData {
+	p := New("dev")()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("unable to configure the provider: %v", diags)
+	}
+	return p.Meta().(*metaData)
+}
+
+func TestProviderHTTPTimeout(t *testing.T) {
+	mux := testServerMux()
+	mux.HandleFunc("/api/v2/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	md := testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token"})
+	if md.api.httpClient.Timeout != defaultHTTPTimeout {
+		t.Errorf("expected the default timeout, got %s", md.api.httpClient.Timeout)
+	}
+
+	md = testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token", "http_timeout": "50ms"})
+	err := md.api.doJSON(context.Background(), http.MethodGet, "/api/v2/slow", nil, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "Timeout") {
+		t.Fatalf("expected the request to time out, got: %v", err)
+	}
+}
+
+func TestProviderRetries(t *testing.T) {
+	srv := httptest.NewServer(testServerMux())
+	t.Cleanup(srv.Close)
+
+	md := testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token"})
+	if md.api.maxRetries != defaultMaxRetries || md.api.maxBackoff != defaultMaxBackoff {
+		t.Errorf("expected the default retries, got %d retries up to %s", md.api.maxRetries, md.api.maxBackoff)
+	}
+
+	md = testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token", "max_retries": 0, "max_backoff": "2m"})
+	if md.api.maxRetries != 0 || md.api.maxBackoff != 2*time.Minute {
+		t.Errorf("expected no retries up to 2m, got %d retries up to %s", md.api.maxRetries, md.api.maxBackoff)
+	}
+}
+
+func TestProviderHeaders(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/", testServerMux())
+	var got http.Header
+	mux.HandleFunc("/api/v2/me", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	md := testProviderMeta(t, map[string]interface{}{
+		"host":  srv.URL,
+		"token": "token",
+		"headers": map[string]interface{}{
+			"CF-Access-Client-Id":     "client-id",
+			"CF-Access-Client-Secret": "client-secret",
+		},
+	})
+	if err := md.api.doJSON(context.Background(), http.MethodGet, "/api/v2/me", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("Cf-Access-Client-Id") != "client-id" || got.Get("Cf-Access-Client-Secret") != "client-secret" {
+		t.Errorf("expected the extra headers to be sent, got %v", got)
+	}
+	if got.Get("Authorization") != "Token token" {
+		t.Errorf("expected the token to be sent, got %q", got.Get("Authorization"))
+	}
+	if got.Get("User-Agent") != "terraform-provider-influxdb2/dev" {
+		t.Errorf("expected the provider User-Agent, got %q", got.Get("User-Agent"))
+	}
+
+	md = testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token", "user_agent": "platform-team/1.0"})
+	if err := md.api.doJSON(context.Background(), http.MethodGet, "/api/v2/me", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("User-Agent") != "platform-team/1.0" {
+		t.Errorf("expected the User-Agent to be overridden, got %q", got.Get("User-Agent"))
+	}
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":    srv.URL,
+		"token":   "token",
+		"headers": map[string]interface{}{"authorization": "Token other"},
+	}))
+	if !diags.HasError() {
+		t.Errorf("expected the Authorization header to be rejected")
+	}
+}
+
+func TestProviderDefaultOrg(t *testing.T) {
+	mux := testServerMux()
+	mux.HandleFunc("/api/v2/orgs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("org") != "my-org" {
+			w.Write([]byte(`{"orgs":[]}`))
+			return
+		}
+		w.Write([]byte(`{"orgs":[{"id":"0000000000000001","name":"my-org"}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	md := testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token", "org": "my-org"})
+	if md.orgID != "0000000000000001" {
+		t.Errorf("expected the org to be resolved to its ID, got %q", md.orgID)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceBuckets().Schema, map[string]interface{}{})
+	if err := setDefaultOrgID(d, md); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("org_id").(string); v != "0000000000000001" {
+		t.Errorf("expected org_id to default to the org of the provider, got %q", v)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceBuckets().Schema, map[string]interface{}{"org_id": "0000000000000002"})
+	if err := setDefaultOrgID(d, md); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("org_id").(string); v != "0000000000000002" {
+		t.Errorf("expected org_id to be kept, got %q", v)
+	}
+
+	md = testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token"})
+	d = schema.TestResourceDataRaw(t, dataSourceBuckets().Schema, map[string]interface{}{})
+	if err := setDefaultOrgID(d, md); err == nil {
+		t.Errorf("expected an error without a default Organization")
+	}
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"host": srv.URL, "token": "token", "org": "other-org"}))
+	if !diags.HasError() {
+		t.Errorf("expected an unknown org to fail the configuration")
+	}
+}
+
+func TestProviderHealthCheck(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready","started":"2021-06-01T10:00:00Z","up":"1h"}`))
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"influxdb","message":"storage engine unavailable","status":"fail","checks":[]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"host": srv.URL, "token": "token"}))
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "storage engine unavailable") {
+		t.Fatalf("expected the failing health to be reported, got: %v", diags)
+	}
+
+	testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token": "token", "skip_health_check": true})
+
+	p = New("dev")()
+	diags = p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"host": "http://127.0.0.1:1", "token": "token"}))
+	if !diags.HasError() || diags[0].Summary != "Unable to connect to InfluxDB2" {
+		t.Fatalf("expected an unreachable host to be reported, got: %v", diags)
+	}
+}
+
+func TestProviderTokenFile(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/", testServerMux())
+	var got string
+	mux.HandleFunc("/api/v2/me", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("file-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	md := testProviderMeta(t, map[string]interface{}{"host": srv.URL, "token_file": path})
+	if err := md.api.doJSON(context.Background(), http.MethodGet, "/api/v2/me", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got != "Token file-token" {
+		t.Errorf("expected the token of the file to be sent, got %q", got)
+	}
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"host": srv.URL, "token_file": filepath.Join(t.TempDir(), "missing")}))
+	if !diags.HasError() {
+		t.Errorf("expected a missing token_file to fail the configuration")
+	}
+}
+
+func TestProviderProfile(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/", testServerMux())
+	var got string
+	mux.HandleFunc("/api/v2/orgs", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"orgs":[{"id":"0000000000000001","name":"` + r.URL.Query().Get("org") + `"}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	path := filepath.Join(t.TempDir(), "configs")
+	configs := fmt.Sprintf(`[default]
+  url = "http://localhost:8086"
+  token = "default-token"
+  org = "default-org"
+  active = true
+
+[test]
+  url = "%s"
+  token = "profile-token"
+  org = "profile-org"
+  active = false
+`, srv.URL)
+	if err := ioutil.WriteFile(path, []byte(configs), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	md := testProviderMeta(t, map[string]interface{}{"profile": "test", "config_file": path})
+	if md.api.host != srv.URL || got != "Token profile-token" || md.orgID != "0000000000000001" {
+		t.Errorf("expected the host, token & org of the profile, got %s, %q & %q", md.api.host, got, md.orgID)
+	}
+
+	// the arguments take precedence over the profile
+	md = testProviderMeta(t, map[string]interface{}{"profile": "test", "config_file": path, "token": "token", "org_id": "0000000000000002"})
+	if md.api.token != "token" || md.orgID != "0000000000000002" {
+		t.Errorf("expected the token & org_id arguments, got %q & %q", md.api.token, md.orgID)
+	}
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"profile": "missing", "config_file": path}))
+	if !diags.HasError() {
+		t.Errorf("expected a missing profile to fail the configuration")
+	}
+}
+
+func TestProviderEnvDefaults(t *testing.T) {
+	for _, name := range []string{"INFLUX_HOST", "INFLUXDB2_URL", "INFLUX_TOKEN", "INFLUXDB2_TOKEN", "INFLUX_ORG", "INFLUXDB2_ORG"} {
+		if v, ok := os.LookupEnv(name); ok {
+			name := name
+			t.Cleanup(func() { os.Setenv(name, v) })
+		}
+		os.Unsetenv(name)
+	}
+	p := New("dev")()
+
+	os.Setenv("INFLUXDB2_URL", "http://influxdb2:8086")
+	os.Setenv("INFLUXDB2_TOKEN", "influxdb2-token")
+	defer os.Unsetenv("INFLUXDB2_URL")
+	defer os.Unsetenv("INFLUXDB2_TOKEN")
+	if v, _ := p.Schema["host"].DefaultValue(); v != "http://influxdb2:8086" {
+		t.Errorf("expected the host to fall back to INFLUXDB2_URL, got %v", v)
+	}
+	if v, _ := p.Schema["token"].DefaultValue(); v != "influxdb2-token" {
+		t.Errorf("expected the token to fall back to INFLUXDB2_TOKEN, got %v", v)
+	}
+
+	os.Setenv("INFLUXDB2_ORG", "influxdb2-org")
+	defer os.Unsetenv("INFLUXDB2_ORG")
+	if v, _ := p.Schema["org"].DefaultValue(); v != "influxdb2-org" {
+		t.Errorf("expected the org to fall back to INFLUXDB2_ORG, got %v", v)
+	}
+
+	// the influx CLI variables take precedence
+	os.Setenv("INFLUX_HOST", "http://influx:8086")
+	defer os.Unsetenv("INFLUX_HOST")
+	if v, _ := p.Schema["host"].DefaultValue(); v != "http://influx:8086" {
+		t.Errorf("expected the host to be INFLUX_HOST, got %v", v)
+	}
+}
+
+func TestAccProviderSessionAuthentication(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "influxdb2" {
+						host     = "%s"
+						username = "%s"
+						password = "%s"
+					}
+					data "influxdb2_me" "test" {}
+				`, testHost, testUsername, testPassword),
+				Check: resource.TestCheckResourceAttr("data.influxdb2_me.test", "name", testUsername),
+			},
+			{
+				Config: fmt.Sprintf(`
+					provider "influxdb2" {
+						host     = "%s"
+						username = "%s"
+						password = "wrong"
+					}
+					data "influxdb2_me" "test" {}
+				`, testHost, testUsername),
+				ExpectError: regexp.MustCompile("Unable to sign in to InfluxDB2"),
+			},
+		},
+	})
+}
+
 func testConfig(res ...string) string {
-	provider := `
+	provider := fmt.Sprintf(`
 		provider "influxdb2" {
-			host     = "http://localhost:8086"
-			token    = "oops_this_is_committed_to_source_control"
+			host     = "%s"
+			token    = "%s"
 		}
-	`
+	`, testHost, testToken)
 
 	c := []string{provider}
 	c = append(c, res...)
@@ -61,3 +415,245 @@ func importStep(name string, ignore ...string) resource.TestStep {
 
 	return step
 }
+
+// testAccInitialBucketID returns the ID of the bucket created by the test server's setup,
+// for resources that need a bucket to refer to. Like resource.Test, it skips the test
+// unless acceptance tests are enabled.
+func testAccInitialBucketID(t *testing.T) string {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	client := influxdb2.NewClient(testHost, testToken)
+	defer client.Close()
+
+	bucket, err := client.BucketsAPI().FindBucketByName(context.Background(), testInitialBucket)
+	if err != nil {
+		t.Fatalf("unable to find the %s bucket: %v", testInitialBucket, err)
+	}
+	return *bucket.Id
+}
+
+// testAccInitialOrgID returns the ID of the Organization created by the test server's setup.
+// Like resource.Test, it skips the test unless acceptance tests are enabled.
+func testAccInitialOrgID(t *testing.T) string {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	org, err := newAPIClient(testHost, testToken).findOrganizationByName(context.Background(), testInitialOrg)
+	if err != nil {
+		t.Fatalf("unable to find the %s Organization: %v", testInitialOrg, err)
+	}
+	return *org.Id
+}
+
+// importStepWithOrgID is an importStep for resources imported with an `<org_id>/<id>` ID.
+func importStepWithOrgID(name string, ignore ...string) resource.TestStep {
+	step := importStep(name, ignore...)
+	step.ImportStateIdFunc = func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+		return rs.Primary.Attributes["org_id"] + "/" + rs.Primary.ID, nil
+	}
+	return step
+}
+
+// testAccUser creates a user for resources that need a user to refer to, and deletes it
+// when the test finishes. Like resource.Test, it skips the test unless acceptance tests
+// are enabled.
+func testAccUser(t *testing.T, name string) string {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	client := influxdb2.NewClient(testHost, testToken)
+
+	user, err := client.UsersAPI().CreateUserWithName(context.Background(), name)
+	if err != nil {
+		client.Close()
+		t.Fatalf("unable to create user %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		defer client.Close()
+		if err := client.UsersAPI().DeleteUserWithID(context.Background(), *user.Id); err != nil {
+			t.Errorf("unable to delete user %s: %v", name, err)
+		}
+	})
+	return *user.Id
+}
+
+// testAccLabel creates a label of an Organization for data sources that read labels, and
+// deletes it when the test finishes. The provider has no label resource, so labels are
+// created with the API directly.
+func testAccLabel(t *testing.T, orgID string, name string, color string) string {
+	api := newAPIClient(testHost, testToken)
+
+	var created domain.LabelResponse
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/labels", nil, map[string]interface{}{
+		"orgID":      orgID,
+		"name":       name,
+		"properties": map[string]string{"color": color},
+	}, &created); err != nil || created.Label == nil {
+		t.Fatalf("unable to create label %s: %v", name, err)
+	}
+	id := *created.Label.Id
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/labels/"+id, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete label %s: %v", name, err)
+		}
+	})
+	return id
+}
+
+// testAccCheck creates a threshold check on the initial bucket for data sources that read
+// checks, and deletes it when the test finishes. The provider has no check resource, so
+// checks are created with the API directly.
+func testAccCheck(t *testing.T, orgID string, name string, labelIDs ...string) string {
+	api := newAPIClient(testHost, testToken)
+
+	var created check
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/checks", nil, map[string]interface{}{
+		"orgID":  orgID,
+		"name":   name,
+		"type":   "threshold",
+		"status": "active",
+		"every":  "1m",
+		"query": map[string]string{
+			"text": `from(bucket: "` + testInitialBucket + `") |> range(start: -1m) |> filter(fn: (r) => r._field == "value") |> mean()`,
+		},
+		"statusMessageTemplate": "Check: ${ r._check_name } is: ${ r._level }",
+		"thresholds": []map[string]interface{}{
+			{"type": "greater", "level": "CRIT", "value": 10},
+		},
+	}, &created); err != nil {
+		t.Fatalf("unable to create check %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/checks/"+created.ID, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete check %s: %v", name, err)
+		}
+	})
+	for _, labelID := range labelIDs {
+		if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/checks/"+created.ID+"/labels", nil, map[string]string{"labelID": labelID}, nil); err != nil {
+			t.Fatalf("unable to add label %s to check %s: %v", labelID, name, err)
+		}
+	}
+	return created.ID
+}
+
+// testAccNotificationEndpoint creates an HTTP notification endpoint for data sources that
+// read notification endpoints, and deletes it when the test finishes. The provider has no
+// notification endpoint resource, so endpoints are created with the API directly.
+func testAccNotificationEndpoint(t *testing.T, orgID string, name string) string {
+	api := newAPIClient(testHost, testToken)
+
+	var created notificationEndpoint
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/notificationEndpoints", nil, map[string]interface{}{
+		"orgID":      orgID,
+		"name":       name,
+		"type":       "http",
+		"status":     "active",
+		"method":     "POST",
+		"authMethod": "none",
+		"url":        "http://localhost:8080/alerts",
+	}, &created); err != nil {
+		t.Fatalf("unable to create notification endpoint %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/notificationEndpoints/"+created.ID, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete notification endpoint %s: %v", name, err)
+		}
+	})
+	return created.ID
+}
+
+// testAccNotificationRule creates a rule sending the critical statuses with the given tags
+// to a notification endpoint, and deletes it when the test finishes.
+func testAccNotificationRule(t *testing.T, orgID string, name string, endpointID string, tags map[string]string) string {
+	api := newAPIClient(testHost, testToken)
+
+	tagRules := []map[string]string{}
+	for k, v := range tags {
+		tagRules = append(tagRules, map[string]string{"key": k, "value": v, "operator": "equal"})
+	}
+	var created notificationRule
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/notificationRules", nil, map[string]interface{}{
+		"orgID":       orgID,
+		"name":        name,
+		"type":        "http",
+		"status":      "active",
+		"endpointID":  endpointID,
+		"every":       "10m",
+		"statusRules": []map[string]string{{"currentLevel": "CRIT"}},
+		"tagRules":    tagRules,
+	}, &created); err != nil {
+		t.Fatalf("unable to create notification rule %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/notificationRules/"+created.ID, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete notification rule %s: %v", name, err)
+		}
+	})
+	return created.ID
+}
+
+// testAccTelegrafConfig creates a Telegraf configuration writing to the initial bucket, and
+// deletes it when the test finishes. The provider has no Telegraf configuration resource,
+// so configurations are created with the API directly.
+func testAccTelegrafConfig(t *testing.T, orgID string, name string, config string) string {
+	api := newAPIClient(testHost, testToken)
+
+	var created telegraf
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/telegrafs", nil, map[string]interface{}{
+		"orgID":    orgID,
+		"name":     name,
+		"metadata": map[string]interface{}{"buckets": []string{testInitialBucket}},
+		"config":   config,
+	}, &created); err != nil {
+		t.Fatalf("unable to create telegraf configuration %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/telegrafs/"+created.ID, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete telegraf configuration %s: %v", name, err)
+		}
+	})
+	return created.ID
+}
+
+// testAccVariable creates a dashboard variable with the given arguments, e.g.
+// `{"type": "constant", "values": ["a", "b"]}`, and deletes it when the test finishes.
+func testAccVariable(t *testing.T, orgID string, name string, arguments map[string]interface{}) string {
+	api := newAPIClient(testHost, testToken)
+
+	var created variable
+	if err := api.doJSON(context.Background(), http.MethodPost, "/api/v2/variables", nil, map[string]interface{}{
+		"orgID":     orgID,
+		"name":      name,
+		"arguments": arguments,
+	}, &created); err != nil {
+		t.Fatalf("unable to create variable %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := api.doJSON(context.Background(), http.MethodDelete, "/api/v2/variables/"+created.ID, nil, nil, nil); err != nil {
+			t.Errorf("unable to delete variable %s: %v", name, err)
+		}
+	})
+	return created.ID
+}
+
+// testAccSkipUnlessEndpoint skips the test when the test server doesn't have the API path,
+// for features that need a newer InfluxDB2 release than the docker-compose one, or Cloud.
+// Like resource.Test, it also skips the test unless acceptance tests are enabled.
+func testAccSkipUnlessEndpoint(t *testing.T, path string, query url.Values) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	err := newAPIClient(testHost, testToken).doJSON(context.Background(), http.MethodGet, path, query, nil, nil)
+	if isNotFound(err) {
+		t.Skipf("the test server doesn't support %s: %v", path, err)
+	}
+}
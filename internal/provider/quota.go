@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Kinds of objects limited by InfluxDB Cloud quotas.
+const (
+	quotaBucket    = "bucket"
+	quotaTask      = "task"
+	quotaDashboard = "dashboard"
+)
+
+// quotaTemplateKinds maps template kinds to the quota they count towards.
+var quotaTemplateKinds = map[string]string{
+	"Bucket":    quotaBucket,
+	"Task":      quotaTask,
+	"Dashboard": quotaDashboard,
+}
+
+// checkQuota fails when creating `adding` more objects of a kind would exceed the
+// Organization's quota, when `check_quotas` is set on the provider. This catches a full free
+// tier Organization at plan time, instead of a 403 halfway through an apply. Instances
+// without quotas, i.e. OSS, always pass.
+func checkQuota(ctx context.Context, meta interface{}, orgID string, kind string, adding int) error {
+	md, ok := meta.(*metaData)
+	if !ok || !md.checkQuotas || adding <= 0 {
+		return nil
+	}
+
+	limits, err := md.api.findOrgLimits(ctx, orgID)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[DEBUG] Organization (%s) has no quotas, skipping the %s quota check", orgID, kind)
+			return nil
+		}
+		return fmt.Errorf("unable to retrieve the quotas of Organization (%s): %v", orgID, err)
+	}
+
+	var max int
+	var count func(context.Context, string) (int, error)
+	switch kind {
+	case quotaBucket:
+		max, count = limits.Bucket.MaxBuckets, md.api.countBuckets
+	case quotaTask:
+		max, count = limits.Task.MaxTasks, md.api.countTasks
+	case quotaDashboard:
+		max, count = limits.Dashboard.MaxDashboards, md.api.countDashboards
+	default:
+		return fmt.Errorf("unknown quota %q", kind)
+	}
+	if max <= 0 {
+		return nil
+	}
+
+	existing, err := count(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("unable to count the %ss of Organization (%s): %v", kind, orgID, err)
+	}
+	if existing+adding > max {
+		return fmt.Errorf("would exceed %s quota (%d) of Organization (%s), which has %d %ss and would get %d more", kind, max, orgID, existing, kind, adding)
+	}
+	return nil
+}
+
+// checkBucketCardinality warns when the series cardinality of a bucket is above
+// `cardinality_warning_percent` of its Organization's cardinality quota, so that plans give
+// an early capacity warning. The check never fails a read: when the usage can't be
+// retrieved, it is only logged. Instances without quotas, i.e. OSS, are skipped.
+func checkBucketCardinality(ctx context.Context, meta interface{}, orgID string, bucketID string) diag.Diagnostics {
+	md, ok := meta.(*metaData)
+	if !ok || md.cardinalityWarningPercent <= 0 || orgID == "" {
+		return nil
+	}
+
+	limits, err := md.api.findOrgLimits(ctx, orgID)
+	if err != nil {
+		if !isNotFound(err) {
+			log.Printf("[WARN] Unable to retrieve the quotas of Organization (%s), skipping the cardinality check of bucket (%s): %v", orgID, bucketID, err)
+		}
+		return nil
+	}
+	max := limits.Rate.Cardinality
+	if max <= 0 {
+		return nil
+	}
+
+	cardinality, err := md.api.bucketCardinality(ctx, orgID, bucketID)
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve the cardinality of bucket (%s), skipping its cardinality check: %v", bucketID, err)
+		return nil
+	}
+	percent := cardinality * 100 / max
+	if percent < md.cardinalityWarningPercent {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Bucket (%s) is at %d%% of the cardinality quota", bucketID, percent),
+		Detail:   fmt.Sprintf("The series cardinality of bucket (%s) is %d, out of the quota of %d series of Organization (%s). Writes of new series are rejected once the quota is reached.", bucketID, cardinality, max, orgID),
+	}}
+}
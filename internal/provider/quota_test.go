@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Quotas only exist on InfluxDB Cloud, so they are tested against a fake server.
+func testQuotaServer(t *testing.T, limits string) *metaData {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/orgs/0000000000000001/limits", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if limits == "" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not found","message":"path not found"}`))
+			return
+		}
+		w.Write([]byte(limits))
+	})
+	mux.HandleFunc("/api/v2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"buckets":[{"type":"user"},{"type":"system"},{"type":"system"}]}`))
+	})
+	mux.HandleFunc("/api/v2/buckets/0000000000000002", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"0000000000000002","orgID":"0000000000000001","name":"default","retentionRules":[]}`))
+	})
+	mux.HandleFunc("/api/v2/orgs/0000000000000001", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"0000000000000001","name":"my-org"}`))
+	})
+	mux.HandleFunc("/api/v2/dashboards", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"dashboards":[{},{}]}`))
+	})
+	mux.HandleFunc("/api/v2/query", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte("#datatype,string,long,long\n#group,false,false,false\n#default,_result,,\n,result,table,_value\n,,0,850\n"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return &metaData{api: newAPIClient(srv.URL, "token"), checkQuotas: true}
+}
+
+func TestCheckQuota(t *testing.T) {
+	const limits = `{"limits":{"bucket":{"maxBuckets":2},"dashboard":{"maxDashboards":5},"task":{"maxTasks":0}}}`
+
+	cases := []struct {
+		name   string
+		limits string
+		kind   string
+		adding int
+		err    string
+	}{
+		{"within quota", limits, quotaBucket, 1, ""},
+		{"system buckets don't count", limits, quotaBucket, 1, ""},
+		{"exceeds quota", limits, quotaBucket, 2, "would exceed bucket quota (2)"},
+		{"dashboards within quota", limits, quotaDashboard, 3, ""},
+		{"dashboards exceed quota", limits, quotaDashboard, 4, "would exceed dashboard quota (5)"},
+		{"unlimited", limits, quotaTask, 100, ""},
+		{"unwrapped limits", `{"bucket":{"maxBuckets":1}}`, quotaBucket, 1, "would exceed bucket quota (1)"},
+		{"no quotas on OSS", "", quotaBucket, 100, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			md := testQuotaServer(t, c.limits)
+
+			err := checkQuota(context.Background(), md, "0000000000000001", c.kind, c.adding)
+			if c.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", c.err, err)
+			}
+		})
+	}
+
+	md := testQuotaServer(t, limits)
+	md.checkQuotas = false
+	if err := checkQuota(context.Background(), md, "0000000000000001", quotaBucket, 100); err != nil {
+		t.Fatalf("expected no check unless check_quotas is set, got: %v", err)
+	}
+}
+
+func TestQuotaCustomizeDiffDefaultOrg(t *testing.T) {
+	const limits = `{"limits":{"dashboard":{"maxDashboards":2}}}`
+	const template = `[{"kind":"Dashboard","metadata":{"name":"a"}}]`
+
+	cases := []struct {
+		name     string
+		resource string
+		config   map[string]interface{}
+		err      string
+	}{
+		{"dashboard in the provider org", "influxdb2_dashboard", map[string]interface{}{"body_json": testDashboardJSON}, "would exceed dashboard quota (2)"},
+		{"dashboard in an unknown org", "influxdb2_dashboard", map[string]interface{}{"body_json": testDashboardJSON, "org_id": unknownValue}, ""},
+		{"template in the provider org", "influxdb2_template_apply", map[string]interface{}{"template": template}, "would exceed dashboard quota (2)"},
+		{"template in an unknown org", "influxdb2_template_apply", map[string]interface{}{"template": template, "org_id": unknownValue}, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			md := testQuotaServer(t, limits)
+			md.orgID = "0000000000000001"
+
+			r := New("test")().ResourcesMap[c.resource]
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.config), md)
+			if c.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", c.err, err)
+			}
+		})
+	}
+
+	// the org_id a dashboard defaulted to at create doesn't replace it
+	state := &terraform.InstanceState{ID: "0000000000000003", Attributes: map[string]string{
+		"org_id":    "0000000000000001",
+		"body_json": testDashboardJSON,
+	}}
+	diff, err := resourceDashboard().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"body_json": testDashboardJSON}), &metaData{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["org_id"] != nil {
+		t.Fatalf("expected no org_id diff, got: %+v", diff.Attributes["org_id"])
+	}
+}
+
+func TestCheckBucketCardinality(t *testing.T) {
+	cases := []struct {
+		name    string
+		limits  string
+		percent int
+		warning string
+	}{
+		{"above threshold", `{"limits":{"rate":{"cardinality":1000}}}`, 80, "is at 85% of the cardinality quota"},
+		{"below threshold", `{"limits":{"rate":{"cardinality":1000}}}`, 90, ""},
+		{"unlimited", `{"limits":{"rate":{"cardinality":0}}}`, 1, ""},
+		{"no quotas on OSS", "", 1, ""},
+		{"disabled", `{"limits":{"rate":{"cardinality":1000}}}`, 0, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			md := testQuotaServer(t, c.limits)
+			md.cardinalityWarningPercent = c.percent
+
+			diags := checkBucketCardinality(context.Background(), md, "0000000000000001", "0000000000000002")
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if c.warning == "" {
+				if len(diags) != 0 {
+					t.Fatalf("expected no warning, got: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || !strings.Contains(diags[0].Summary, c.warning) {
+				t.Fatalf("expected a warning containing %q, got: %v", c.warning, diags)
+			}
+		})
+	}
+}
+
+func TestEnvironmentQuotas(t *testing.T) {
+	// a bucket found missing is only re-created within the bucket quota
+	md := testQuotaServer(t, `{"limits":{"bucket":{"maxBuckets":1}}}`)
+	state := &terraform.InstanceState{ID: "0000000000000001", Attributes: map[string]string{
+		"name":        "my-org",
+		"org_id":      "0000000000000001",
+		"bucket_name": "default",
+		"bucket_id":   "",
+		"token_id":    "0000000000000003",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "my-org"})
+	if _, err := resourceEnvironment().Diff(context.Background(), state, config, md); err == nil || !strings.Contains(err.Error(), "would exceed bucket quota (1)") {
+		t.Fatalf("expected the bucket quota to be exceeded, got: %v", err)
+	}
+	state.Attributes["bucket_id"] = "0000000000000002"
+	if _, err := resourceEnvironment().Diff(context.Background(), state, config, md); err != nil {
+		t.Fatalf("expected no quota check without a bucket to re-create, got: %v", err)
+	}
+
+	// the bucket of the Environment gets the cardinality warning of other buckets
+	md = testQuotaServer(t, `{"limits":{"rate":{"cardinality":1000}}}`)
+	md.cardinalityWarningPercent = 80
+	d := resourceEnvironment().TestResourceData()
+	d.SetId("0000000000000001")
+	d.Set("bucket_id", "0000000000000002")
+	diags := resourceEnvironmentRead(context.Background(), d, md)
+	if diags.HasError() || len(diags) != 1 || !strings.Contains(diags[0].Summary, "is at 85% of the cardinality quota") {
+		t.Fatalf("expected a cardinality warning, got: %v", diags)
+	}
+}
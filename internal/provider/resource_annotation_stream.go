@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAnnotationStream() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Annotation Stream resource allows you to configure an InfluxDB2 annotation stream, e.g. for deployment markers written by CI pipelines. Requires InfluxDB2 OSS 2.1 or later, or InfluxDB Cloud. Destroying a stream also deletes its annotations.",
+
+		CreateContext: withDefaultOrgID(resourceAnnotationStreamCreate),
+		ReadContext:   resourceAnnotationStreamRead,
+		UpdateContext: resourceAnnotationStreamUpdate,
+		DeleteContext: resourceAnnotationStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAnnotationStreamImport,
+		},
+
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the stream, unique in the Organization.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the stream. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the stream.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the stream.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, createdUpdatedSchema("stream")),
+	}
+}
+
+func resourceAnnotationStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	name := d.Get("name").(string)
+
+	// Check for an existing stream, the API would silently update it
+	existing, err := api.findAnnotationStreamByName(ctx, orgID, name)
+	if err != nil {
+		return diag.Errorf("unable to check for presence of an existing Annotation Stream (%s) in Organization (%s): %v", name, orgID, err)
+	}
+	if existing != nil {
+		return diag.Errorf("unable to create Annotation Stream (%s) - a stream with this name already exists in Organization (%s) with ID (%s); see resource documentation for influxdb2_annotation_stream for instructions on how to add an already existing stream to the state", name, orgID, existing.ID)
+	}
+
+	log.Printf("[INFO] Creating Annotation Stream (%s)", name)
+	created, err := api.createAnnotationStream(ctx, orgID, &annotationStreamWrite{
+		Name:        name,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Annotation Stream (%s): %v", name, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Annotation Stream (%s): <unknown error occurred>", name)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Annotation Stream (%s) (%s)", name, created.ID)
+
+	return resourceAnnotationStreamRead(ctx, d, meta)
+}
+
+func resourceAnnotationStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Annotation Stream (%s)", id)
+
+	s, err := api.findAnnotationStreamByID(ctx, d.Get("org_id").(string), id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Annotation Stream (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Annotation Stream (%s): %v", id, err)
+	}
+
+	if err := setAnnotationStreamResourceData(d, s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAnnotationStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Annotation Stream (%s)", id)
+	if _, err := api.updateAnnotationStream(ctx, d.Get("org_id").(string), id, &annotationStreamWrite{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}); err != nil {
+		return diag.Errorf("unable to update Annotation Stream (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Annotation Stream (%s)", id)
+
+	return resourceAnnotationStreamRead(ctx, d, meta)
+}
+
+func resourceAnnotationStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Annotation Stream (%s)", id)
+
+	if err := api.deleteAnnotationStream(ctx, d.Get("org_id").(string), id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Annotation Stream (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Annotation Stream (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Annotation Stream (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setAnnotationStreamResourceData(d *schema.ResourceData, s *annotationStream) error {
+	if err := d.Set("id", s.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", s.Description); err != nil {
+		return err
+	}
+	if err := d.Set("created_at", s.CreatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", s.UpdatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("created_timestamp", s.CreatedAt.Unix()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_timestamp", s.UpdatedAt.Unix()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceAnnotationStreamImport imports a stream using an ID of the form
+// `<org_id>/<stream_id>`, since the API requires the Organization ID for every request.
+func resourceAnnotationStreamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <org_id>/<stream_id>", d.Id())
+	}
+
+	if err := d.Set("org_id", parts[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
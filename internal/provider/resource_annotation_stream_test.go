@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxAnnotationStream(name string, description string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_annotation_stream" "deployments" {
+			org_id      = data.influxdb2_organization.initial.id
+			name        = "%s"
+			description = "%s"
+		}
+`, testInitialOrg, name, description)
+}
+
+func TestAccResourceAnnotationStream(t *testing.T) {
+	testAccSkipUnlessEndpoint(t, "/api/v2/streams", url.Values{"orgID": []string{testAccInitialOrgID(t)}})
+
+	name := acctest.RandomWithPrefix("test-stream")
+	newName := acctest.RandomWithPrefix("test-stream")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceAnnotationStreamDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxAnnotationStream(name, "first")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_annotation_stream.deployments", "name", name),
+					resource.TestCheckResourceAttr("influxdb2_annotation_stream.deployments", "description", "first"),
+					testAccResourceAnnotationStreamExists(provider, "influxdb2_annotation_stream.deployments"),
+				),
+			},
+			importStepWithOrgID("influxdb2_annotation_stream.deployments"),
+			{
+				//update
+				Config: testConfig(influxAnnotationStream(newName, "second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_annotation_stream.deployments", "name", newName),
+					resource.TestCheckResourceAttr("influxdb2_annotation_stream.deployments", "description", "second"),
+					testAccResourceAnnotationStreamExists(provider, "influxdb2_annotation_stream.deployments"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAnnotationStreamCreateExisting(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected the existing stream to be left alone, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"0000000000000002","orgID":"0000000000000001","stream":"deployments"}]`))
+	}))
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAnnotationStream().Schema, map[string]interface{}{
+		"org_id": "0000000000000001",
+		"name":   "deployments",
+	})
+	diags := resourceAnnotationStreamCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "a stream with this name already exists") {
+		t.Fatalf("expected an already exists error, got: %v", diags)
+	}
+}
+
+func testAccResourceAnnotationStreamExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findAnnotationStreamByID(context.Background(), rs.Primary.Attributes["org_id"], id); err != nil {
+			return fmt.Errorf("Got an error when reading Annotation Stream %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceAnnotationStreamDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_annotation_stream" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findAnnotationStreamByID(context.Background(), rs.Primary.Attributes["org_id"], id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Annotation Stream %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBackup() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Backup resource backs up the metadata & data of an InfluxDB OSS instance to a local directory when it is created, e.g. before an upgrade applied by the same configuration. The files are those of `influx backup`, so they can be restored by `influx restore` or the `influxdb2_restore` resource. Any change backs up again, use `triggers` to re-run a backup. Backups require an operator token. Destroying the resource only removes it from state: the backup files are kept.",
+
+		CreateContext: resourceBackupCreate,
+		ReadContext:   resourceBackupRead,
+		DeleteContext: resourceBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"path": {
+				Description:      "Path of the local directory the backup files are written to. It is created if missing.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"bucket_id": {
+				Description: "ID of the bucket to back up the data of. Defaults to all buckets. The metadata is always backed up in full.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values that back up again when changed.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"manifest": {
+				Description: "Name of the manifest file of the backup, in `path`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"backed_up_at": {
+				Description: "When the backup was made.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	path := d.Get("path").(string)
+
+	log.Printf("[INFO] Backing up to (%s)", path)
+	manifest, err := api.backup(ctx, path, d.Get("bucket_id").(string))
+	if err != nil {
+		return diag.Errorf("unable to back up to (%s): %v", path, err)
+	}
+
+	backedUpAt := time.Now().UTC()
+	d.SetId(fmt.Sprintf("%s/%s", path, manifest))
+	if err := d.Set("manifest", manifest); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backed_up_at", backedUpAt.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Backed up to (%s) (%s)", path, manifest)
+
+	return nil
+}
+
+// resourceBackupRead does nothing, since the backup files are managed outside of Terraform
+// once written.
+func resourceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Backup files are kept, removing Backup (%s) from state only", d.Id())
+
+	return nil
+}
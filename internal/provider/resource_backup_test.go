@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testBackupBuckets = `[
+	{
+		"organizationID": "0000000000000001",
+		"organizationName": "org",
+		"bucketID": "0000000000000002",
+		"bucketName": "metrics",
+		"defaultRetentionPolicy": "autogen",
+		"retentionPolicies": [{
+			"name": "autogen",
+			"replicaN": 1,
+			"duration": 0,
+			"shardGroupDuration": 604800000000000,
+			"shardGroups": [{
+				"id": 1,
+				"startTime": "2021-05-31T00:00:00Z",
+				"endTime": "2021-06-07T00:00:00Z",
+				"shards": [{"id": 1, "shardOwners": [{"nodeID": 0}]}, {"id": 2, "shardOwners": [{"nodeID": 0}]}]
+			}],
+			"subscriptions": []
+		}]
+	},
+	{
+		"organizationID": "0000000000000001",
+		"organizationName": "org",
+		"bucketID": "0000000000000003",
+		"bucketName": "logs",
+		"defaultRetentionPolicy": "autogen",
+		"retentionPolicies": []
+	}
+]`
+
+// testBackupServer serves the metadata of testBackupBuckets, and the data of shard 1. Shard 2
+// is reported as deleted.
+func testBackupServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(testBackupMux(t))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testBackupMux(t *testing.T) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/backup/metadata", func(w http.ResponseWriter, r *http.Request) {
+		mw := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", "multipart/mixed; boundary="+mw.Boundary())
+		for _, part := range []struct{ name, contentType, body string }{
+			{"kv", "application/octet-stream", "bolt"},
+			{"sql", "application/octet-stream", "sqlite"},
+			{"buckets", "application/json", testBackupBuckets},
+		} {
+			pw, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":        {part.contentType},
+				"Content-Disposition": {`form-data; name="` + part.name + `"`},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			pw.Write([]byte(part.body))
+		}
+		mw.Close()
+	})
+	mux.HandleFunc("/api/v2/backup/shards/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("shard 1"))
+	})
+	mux.HandleFunc("/api/v2/backup/shards/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"not found","message":"shard 2 not found"}`))
+	})
+	return mux
+}
+
+func TestResourceBackupCreate(t *testing.T) {
+	srv := testBackupServer(t)
+	dir := filepath.Join(t.TempDir(), "backup")
+
+	d := schema.TestResourceDataRaw(t, resourceBackup().Schema, map[string]interface{}{
+		"path":      dir,
+		"bucket_id": "0000000000000002",
+	})
+	if diags := resourceBackupCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, d.Get("manifest").(string)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var manifest backupManifest
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		t.Fatal(err)
+	}
+
+	if manifest.SQL == nil {
+		t.Fatalf("expected the SQL store in the manifest, got %s", b)
+	}
+	for file, content := range map[string]string{manifest.KV.FileName: "bolt", manifest.SQL.FileName: "sqlite"} {
+		if got := readGzipFile(t, filepath.Join(dir, file)); got != content {
+			t.Errorf("expected %q in %s, got %q", content, file, got)
+		}
+	}
+
+	if len(manifest.Buckets) != 1 || manifest.Buckets[0].BucketName != "metrics" {
+		t.Fatalf("expected only the metrics bucket in the manifest, got %s", b)
+	}
+	shards := manifest.Buckets[0].RetentionPolicies[0].ShardGroups[0].Shards
+	if len(shards) != 1 || shards[0].ID != 1 || shards[0].FileName == "" {
+		t.Fatalf("expected only shard 1 in the manifest, got %s", b)
+	}
+	if got := readGzipFile(t, filepath.Join(dir, shards[0].FileName)); got != "shard 1" {
+		t.Errorf("expected the data of shard 1, got %q", got)
+	}
+}
+
+func TestResourceBackupCreateSession(t *testing.T) {
+	mux := testBackupMux(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == signinPath {
+			http.SetCookie(w, &http.Cookie{Name: "influxdb-oss-session", Value: "session", Path: "/"})
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if c, err := r.Cookie("influxdb-oss-session"); err != nil || c.Value != "session" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	api := newSessionAPIClient(srv.URL, "admin", "super-secret")
+	if err := api.signin(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceBackup().Schema, map[string]interface{}{
+		"path": filepath.Join(t.TempDir(), "backup"),
+	})
+	if diags := resourceBackupCreate(context.Background(), d, &metaData{api: api}); diags.HasError() {
+		t.Fatalf("expected the backup to use the session, got: %v", diags)
+	}
+}
+
+func readGzipFile(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
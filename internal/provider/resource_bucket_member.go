@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var bucketMember = userAssociation{
+	itemType:       "Bucket",
+	idAttribute:    "bucket_id",
+	collectionPath: "/api/v2/buckets",
+	role:           "members",
+}
+
+func resourceBucketMember() *schema.Resource {
+	return resourceUserAssociation(bucketMember, "The Bucket Member resource allows you to make a user a member of an InfluxDB2 bucket, granting them read access to it. Removing the resource revokes the membership, without deleting the user.")
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func influxBucketMember(bucketID string, userID string) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_bucket_member" "member" {
+			bucket_id = "%s"
+			user_id   = "%s"
+		}
+`, bucketID, userID)
+}
+
+func TestAccResourceBucketMember(t *testing.T) {
+	userName := acctest.RandomWithPrefix("test-user")
+
+	bucketID := testAccInitialBucketID(t)
+	userID := testAccUser(t, userName)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceUserAssociationDestroy(t, provider, "influxdb2_bucket_member", bucketMember),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxBucketMember(bucketID, userID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_bucket_member.member", "bucket_id", bucketID),
+					resource.TestCheckResourceAttr("influxdb2_bucket_member.member", "user_id", userID),
+					resource.TestCheckResourceAttr("influxdb2_bucket_member.member", "user_name", userName),
+					testAccResourceUserAssociationExists(provider, "influxdb2_bucket_member.member", bucketMember),
+				),
+			},
+			importStep("influxdb2_bucket_member.member"),
+		},
+	})
+}
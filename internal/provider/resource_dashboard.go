@@ -0,0 +1,509 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Dashboard resource allows you to configure a InfluxDB2 Dashboard from the JSON export produced by the InfluxDB UI.",
+
+		CreateContext: withDefaultOrgID(resourceDashboardCreate),
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		CustomizeDiff: resourceDashboardCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"body_json": {
+				Description:      "The Dashboard definition, as exported from the InfluxDB UI (`Export` > `Download JSON`). Changes are detected by comparing a normalized form of the definition, so formatting, key order and server-assigned IDs don't cause diffs.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateDashboardJSON,
+				DiffSuppressFunc: suppressEquivalentDashboardJSON,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the Dashboard. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				// set to the Organization of the provider at create when unset
+				DiffSuppressFunc: suppressDefaultOrgID,
+			},
+			"name": {
+				Description: "Name of the Dashboard, overriding the name in `body_json` when set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"description": {
+				Description: "The description of the Dashboard, overriding the description in `body_json` when set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the Dashboard.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dashboardExport is the subset of the InfluxDB UI dashboard export format that the provider uses.
+type dashboardExport struct {
+	Meta    map[string]interface{} `json:"meta,omitempty"`
+	Content struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+			} `json:"attributes"`
+			Relationships map[string]interface{} `json:"relationships,omitempty"`
+		} `json:"data"`
+		Included []dashboardExportIncluded `json:"included"`
+	} `json:"content"`
+	Labels []interface{} `json:"labels,omitempty"`
+}
+
+type dashboardExportIncluded struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Attributes map[string]interface{} `json:"attributes"`
+	// Cells reference their view through relationships.view.data.id
+	Relationships struct {
+		View *dashboardExportRef `json:"view,omitempty"`
+	} `json:"relationships"`
+}
+
+type dashboardExportRef struct {
+	Data struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"data"`
+}
+
+// dashboardDefinition is the normalized, ID free form of a Dashboard used for drift detection.
+type dashboardDefinition struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Cells       []dashboardCellDefinition `json:"cells"`
+}
+
+type dashboardCellDefinition struct {
+	X          int32       `json:"x"`
+	Y          int32       `json:"y"`
+	W          int32       `json:"w"`
+	H          int32       `json:"h"`
+	Name       string      `json:"name"`
+	Properties interface{} `json:"properties"`
+}
+
+// parseDashboardJSON converts an exported Dashboard into its normalized definition.
+func parseDashboardJSON(body string) (*dashboardDefinition, error) {
+	var export dashboardExport
+	if err := json.Unmarshal([]byte(body), &export); err != nil {
+		return nil, err
+	}
+	if export.Content.Data.Type != "dashboard" {
+		return nil, fmt.Errorf("expected content.data.type to be \"dashboard\", got %q", export.Content.Data.Type)
+	}
+
+	views := map[string]dashboardExportIncluded{}
+	for _, inc := range export.Content.Included {
+		if inc.Type == "view" {
+			views[inc.ID] = inc
+		}
+	}
+
+	def := &dashboardDefinition{
+		Name:        export.Content.Data.Attributes.Name,
+		Description: export.Content.Data.Attributes.Description,
+		Cells:       []dashboardCellDefinition{},
+	}
+	for _, inc := range export.Content.Included {
+		if inc.Type != "cell" {
+			continue
+		}
+		cell := dashboardCellDefinition{
+			X: jsonInt32(inc.Attributes["x"]),
+			Y: jsonInt32(inc.Attributes["y"]),
+			W: jsonInt32(inc.Attributes["w"]),
+			H: jsonInt32(inc.Attributes["h"]),
+		}
+		if inc.Relationships.View != nil {
+			view, ok := views[inc.Relationships.View.Data.ID]
+			if !ok {
+				return nil, fmt.Errorf("cell %q references missing view %q", inc.ID, inc.Relationships.View.Data.ID)
+			}
+			if name, ok := view.Attributes["name"].(string); ok {
+				cell.Name = name
+			}
+			cell.Properties = view.Attributes["properties"]
+		}
+		def.Cells = append(def.Cells, cell)
+	}
+	def.sortCells()
+
+	return def, nil
+}
+
+func jsonInt32(v interface{}) int32 {
+	if f, ok := v.(float64); ok {
+		return int32(f)
+	}
+	return 0
+}
+
+// sortCells orders the cells by their position on the Dashboard, top to bottom & left to right.
+func (def *dashboardDefinition) sortCells() {
+	sort.SliceStable(def.Cells, func(i, j int) bool {
+		if def.Cells[i].Y != def.Cells[j].Y {
+			return def.Cells[i].Y < def.Cells[j].Y
+		}
+		return def.Cells[i].X < def.Cells[j].X
+	})
+}
+
+// canonical returns the definition as JSON with sorted keys, suitable for comparison.
+func (def *dashboardDefinition) canonical() string {
+	b, _ := json.Marshal(def)
+	// round-trip through interface{} so nested property maps are key sorted too
+	var v interface{}
+	_ = json.Unmarshal(b, &v)
+	b, _ = json.Marshal(v)
+	return string(b)
+}
+
+// exportJSON renders the definition in the InfluxDB UI export format.
+func (def *dashboardDefinition) exportJSON() (string, error) {
+	var export dashboardExport
+	export.Content.Data.Type = "dashboard"
+	export.Content.Data.Attributes.Name = def.Name
+	export.Content.Data.Attributes.Description = def.Description
+
+	cellRefs := []interface{}{}
+	for i, cell := range def.Cells {
+		cellID := fmt.Sprintf("cell-%d", i)
+		viewID := fmt.Sprintf("view-%d", i)
+		cellRefs = append(cellRefs, map[string]interface{}{"type": "cell", "id": cellID})
+
+		c := dashboardExportIncluded{
+			ID:   cellID,
+			Type: "cell",
+			Attributes: map[string]interface{}{
+				"x": cell.X, "y": cell.Y, "w": cell.W, "h": cell.H,
+			},
+		}
+		c.Relationships.View = &dashboardExportRef{}
+		c.Relationships.View.Data.Type = "view"
+		c.Relationships.View.Data.ID = viewID
+
+		v := dashboardExportIncluded{
+			ID:   viewID,
+			Type: "view",
+			Attributes: map[string]interface{}{
+				"name":       cell.Name,
+				"properties": cell.Properties,
+			},
+		}
+		export.Content.Included = append(export.Content.Included, c, v)
+	}
+	export.Content.Data.Relationships = map[string]interface{}{
+		"cell": map[string]interface{}{"data": cellRefs},
+	}
+
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func validateDashboardJSON(v interface{}, path cty.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if _, err := parseDashboardJSON(v.(string)); err != nil {
+		msg := fmt.Sprintf("must be a Dashboard exported from the InfluxDB UI: %v", err)
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       msg,
+			Detail:        msg,
+			AttributePath: path,
+		})
+	}
+
+	return diagnostics
+}
+
+func suppressEquivalentDashboardJSON(k, old, new string, d *schema.ResourceData) bool {
+	oldDef, err := parseDashboardJSON(old)
+	if err != nil {
+		return false
+	}
+	newDef, err := parseDashboardJSON(new)
+	if err != nil {
+		return false
+	}
+	return oldDef.canonical() == newDef.canonical()
+}
+
+// dashboardDefinitionFromResourceData returns the normalized definition, with name &
+// description overridden by the resource arguments when set.
+func dashboardDefinitionFromResourceData(d *schema.ResourceData) (*dashboardDefinition, error) {
+	def, err := parseDashboardJSON(d.Get("body_json").(string))
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := d.GetOk("name"); ok {
+		def.Name = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		def.Description = v.(string)
+	}
+	return def, nil
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	def, err := dashboardDefinitionFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := domain.CreateDashboardRequest{
+		OrgID:       d.Get("org_id").(string),
+		Name:        def.Name,
+		Description: &def.Description,
+	}
+
+	log.Printf("[INFO] Creating Dashboard (%s)", def.Name)
+	var dashboard domain.Dashboard
+	if err := api.doJSON(ctx, http.MethodPost, "/api/v2/dashboards", nil, req, &dashboard); err != nil {
+		return diag.Errorf("unable to create Dashboard (%s): %v", def.Name, err)
+	}
+	if dashboard.Id == nil {
+		return diag.Errorf("unable to create Dashboard (%s): <unknown error occurred>", def.Name)
+	}
+
+	id := *dashboard.Id
+	d.SetId(id)
+
+	log.Printf("[INFO] Created Dashboard (%s) (%s)", def.Name, id)
+
+	rb := newRollback(d)
+	rb.add(fmt.Sprintf("Dashboard (%s)", id), func(ctx context.Context) error {
+		return api.doJSON(ctx, http.MethodDelete, "/api/v2/dashboards/"+id, nil, nil, nil)
+	})
+	if err := createDashboardCells(ctx, api, id, def.Cells); err != nil {
+		return rb.run(ctx, diag.Errorf("unable to create cells for Dashboard (%s) (%s): %v", def.Name, id, err))
+	}
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func createDashboardCells(ctx context.Context, api *apiClient, id string, cells []dashboardCellDefinition) error {
+	for _, c := range cells {
+		cell := c
+		req := domain.CreateCell{
+			X:    &cell.X,
+			Y:    &cell.Y,
+			W:    &cell.W,
+			H:    &cell.H,
+			Name: &cell.Name,
+		}
+
+		var created domain.Cell
+		if err := api.doJSON(ctx, http.MethodPost, fmt.Sprintf("/api/v2/dashboards/%s/cells", id), nil, req, &created); err != nil {
+			return err
+		}
+		if created.Id == nil {
+			return fmt.Errorf("cell (%s) was created without an ID", cell.Name)
+		}
+
+		view := domain.View{
+			Name:       cell.Name,
+			Properties: cell.Properties,
+		}
+		if err := api.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/v2/dashboards/%s/cells/%s/view", id, *created.Id), nil, view, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Dashboard (%s)", id)
+
+	dashboard, err := api.findDashboardByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Dashboard (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Dashboard (%s): %v", id, err)
+	}
+
+	if err := setDashboardResourceData(d, dashboard); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	def, err := dashboardDefinitionFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "body_json") {
+		patch := map[string]string{
+			"name":        def.Name,
+			"description": def.Description,
+		}
+		log.Printf("[INFO] Updating Dashboard (%s)", id)
+		if err := api.doJSON(ctx, http.MethodPatch, "/api/v2/dashboards/"+id, nil, patch, nil); err != nil {
+			return diag.Errorf("unable to update Dashboard (%s): %v", id, err)
+		}
+	}
+
+	if d.HasChange("body_json") {
+		// The cells are replaced wholesale, views can't be matched up reliably by position.
+		var dashboard domain.Dashboard
+		if err := api.doJSON(ctx, http.MethodGet, "/api/v2/dashboards/"+id, nil, nil, &dashboard); err != nil {
+			return diag.Errorf("unable to retrieve Dashboard (%s): %v", id, err)
+		}
+		if dashboard.Cells != nil {
+			for _, cell := range *dashboard.Cells {
+				if cell.Id == nil {
+					continue
+				}
+				if err := api.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/dashboards/%s/cells/%s", id, *cell.Id), nil, nil, nil); err != nil && !isNotFound(err) {
+					return diag.Errorf("unable to remove cell (%s) from Dashboard (%s): %v", *cell.Id, id, err)
+				}
+			}
+		}
+		if err := createDashboardCells(ctx, api, id, def.Cells); err != nil {
+			return diag.Errorf("unable to create cells for Dashboard (%s): %v", id, err)
+		}
+	}
+
+	log.Printf("[INFO] Updated Dashboard (%s)", id)
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Dashboard (%s)", id)
+
+	if err := api.doJSON(ctx, http.MethodDelete, "/api/v2/dashboards/"+id, nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Dashboard (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Dashboard (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Dashboard (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setDashboardResourceData(d *schema.ResourceData, dashboard *domain.DashboardWithViewProperties) error {
+	def := &dashboardDefinition{
+		Name:        dashboard.Name,
+		Description: stringValue(dashboard.Description),
+		Cells:       []dashboardCellDefinition{},
+	}
+	if dashboard.Cells != nil {
+		for _, cell := range *dashboard.Cells {
+			def.Cells = append(def.Cells, dashboardCellDefinition{
+				X:          int32Value(cell.X),
+				Y:          int32Value(cell.Y),
+				W:          int32Value(cell.W),
+				H:          int32Value(cell.H),
+				Name:       stringValue(cell.Name),
+				Properties: cell.Properties,
+			})
+		}
+	}
+	def.sortCells()
+
+	// Only replace body_json when the server side definition has drifted, so that the
+	// user's formatting is kept in state.
+	current, err := dashboardDefinitionFromResourceData(d)
+	if err != nil || current.canonical() != def.canonical() {
+		body, err := def.exportJSON()
+		if err != nil {
+			return err
+		}
+		if err := d.Set("body_json", body); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("id", dashboard.Id); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", dashboard.OrgID); err != nil {
+		return err
+	}
+	// name & description only override body_json: unless they are set, the values of the
+	// server are part of body_json
+	if _, ok := d.GetOk("name"); ok {
+		if err := d.Set("name", dashboard.Name); err != nil {
+			return err
+		}
+	}
+	if _, ok := d.GetOk("description"); ok {
+		if err := d.Set("description", def.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceDashboardCustomizeDiff checks the dashboard quota before a dashboard is created,
+// see checkQuota.
+func resourceDashboardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	orgID, ok := diffOrgID(d, meta)
+	if !ok {
+		return nil
+	}
+	return checkQuota(ctx, meta, orgID, quotaDashboard, 1)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+const testDashboardJSON = `{
+  "meta": {"version": "1", "type": "dashboard", "name": "CPU-Template"},
+  "content": {
+    "data": {
+      "type": "dashboard",
+      "attributes": {"name": "CPU", "description": "cpu usage"},
+      "relationships": {"cell": {"data": [{"type": "cell", "id": "0a"}, {"type": "cell", "id": "0b"}]}}
+    },
+    "included": [
+      {"id": "0b", "type": "cell", "attributes": {"x": 4, "y": 0, "w": 4, "h": 4}, "relationships": {"view": {"data": {"type": "view", "id": "0b"}}}},
+      {"id": "0a", "type": "cell", "attributes": {"x": 0, "y": 0, "w": 4, "h": 4}, "relationships": {"view": {"data": {"type": "view", "id": "0a"}}}},
+      {"id": "0a", "type": "view", "attributes": {"name": "Notes", "properties": {"shape": "chronograf-v2", "type": "markdown", "note": "hello"}}},
+      {"id": "0b", "type": "view", "attributes": {"name": "More notes", "properties": {"type": "markdown", "shape": "chronograf-v2", "note": "world"}}}
+    ]
+  },
+  "labels": []
+}`
+
+func TestDashboardJSONNormalization(t *testing.T) {
+	def, err := parseDashboardJSON(testDashboardJSON)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if def.Name != "CPU" || len(def.Cells) != 2 || def.Cells[0].Name != "Notes" {
+		t.Fatalf("unexpected definition: %+v", def)
+	}
+
+	// a round trip through the export format must be equivalent to the original
+	exported, err := def.exportJSON()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !suppressEquivalentDashboardJSON("body_json", testDashboardJSON, exported, nil) {
+		t.Fatalf("expected exported JSON to be equivalent:\n%s", exported)
+	}
+
+	changed, _ := parseDashboardJSON(testDashboardJSON)
+	changed.Cells[1].W = 6
+	changedJSON, _ := changed.exportJSON()
+	if suppressEquivalentDashboardJSON("body_json", testDashboardJSON, changedJSON, nil) {
+		t.Fatal("expected a resized cell to be detected as a change")
+	}
+}
+
+func influxDashboard(orgName string, dashboardName string) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_organization" "org" {
+			name = "%s"
+		}
+		resource "influxdb2_dashboard" "dashboard" {
+			org_id    = influxdb2_organization.org.id
+			name      = "%s"
+			body_json = <<EOT
+%s
+EOT
+		}
+`, orgName, dashboardName, testDashboardJSON)
+}
+
+func TestAccResourceDashboard(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxDashboard(org, "cpu")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_dashboard.dashboard", "name", "cpu"),
+					testAccResourceDashboardExists(provider, "influxdb2_dashboard.dashboard"),
+					testAccResourceDashboardAttributes(provider, "influxdb2_dashboard.dashboard", "cpu", "cpu usage"),
+				),
+			},
+			// name is only in state when set, the imported name is part of body_json
+			importStep("influxdb2_dashboard.dashboard", "body_json", "name"),
+			{
+				//update
+				Config: testConfig(influxDashboard(org, "cpu usage")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_dashboard.dashboard", "name", "cpu usage"),
+					testAccResourceDashboardExists(provider, "influxdb2_dashboard.dashboard"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDashboardBodyJSONName(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org")
+	config := func(name string, description string) string {
+		body := strings.Replace(testDashboardJSON, `"attributes": {"name": "CPU", "description": "cpu usage"}`,
+			fmt.Sprintf(`"attributes": {"name": %q, "description": %q}`, name, description), 1)
+		return testConfig(fmt.Sprintf(`
+			resource "influxdb2_organization" "org" {
+				name = "%s"
+			}
+			resource "influxdb2_dashboard" "dashboard" {
+				org_id    = influxdb2_organization.org.id
+				body_json = <<EOT
+%s
+EOT
+			}
+		`, org, body))
+	}
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: config("CPU", "cpu usage"),
+				Check:  testAccResourceDashboardAttributes(provider, "influxdb2_dashboard.dashboard", "CPU", "cpu usage"),
+			},
+			{
+				// only editing body_json renames the Dashboard, and the next plan is empty
+				Config: config("CPU v2", "cpu usage per host"),
+				Check:  testAccResourceDashboardAttributes(provider, "influxdb2_dashboard.dashboard", "CPU v2", "cpu usage per host"),
+			},
+		},
+	})
+}
+
+// testAccResourceDashboardAttributes checks the name & description of a Dashboard on the server.
+func testAccResourceDashboardAttributes(testProvider *schema.Provider, name string, dashboardName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		var dashboard domain.Dashboard
+		if err := api.doJSON(context.Background(), "GET", "/api/v2/dashboards/"+rs.Primary.ID, nil, nil, &dashboard); err != nil {
+			return fmt.Errorf("Got an error when reading Dashboard %q: %v", rs.Primary.ID, err)
+		}
+		if dashboard.Name != dashboardName || stringValue(dashboard.Description) != description {
+			return fmt.Errorf("expected Dashboard %q with description %q, got %q with description %q", dashboardName, description, dashboard.Name, stringValue(dashboard.Description))
+		}
+		return nil
+	}
+}
+
+func testAccResourceDashboardExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if err := api.doJSON(context.Background(), "GET", "/api/v2/dashboards/"+id, nil, nil, nil); err != nil {
+			return fmt.Errorf("Got an error when reading Dashboard %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
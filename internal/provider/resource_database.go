@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabase() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Database resource allows you to configure a database of an InfluxDB Cloud Dedicated cluster, which Dedicated has in place of buckets. It is managed through the Management API configured by the `cloud_dedicated` provider block. The partition template can't be changed once the database is created.",
+
+		CreateContext: resourceDatabaseCreate,
+		ReadContext:   resourceDatabaseRead,
+		UpdateContext: resourceDatabaseUpdate,
+		DeleteContext: resourceDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the database.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"retention_period": {
+				Description: "Duration in seconds for how long data is kept in the database, `0` for infinite retention.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"max_tables": {
+				Description: "Maximum number of tables of the database. Defaults to the cluster default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_columns_per_table": {
+				Description: "Maximum number of columns per table of the database. Defaults to the cluster default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"partition_template": {
+				Description: "The parts of the partition template of the database, in order. Defaults to the cluster default, partitioning by day.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        databasePartitionSchema(),
+			},
+			// Computed outputs
+			"account_id": {
+				Description: "ID of the Cloud Dedicated account.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "ID of the Cloud Dedicated cluster.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func databasePartitionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:      "Type of the part: `tag`, `bucket` or `time`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{"tag", "bucket", "time"}, false),
+			},
+			"tag_name": {
+				Description: "Name of the tag to partition by, for `tag` & `bucket` parts.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"number_of_buckets": {
+				Description: "Number of buckets the tag values are hashed into, for `bucket` parts.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"time_format": {
+				Description: "A strftime format of the partition time, e.g. `%Y-%m-%d`, for `time` parts.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func expandDatabasePartitionTemplate(raw []interface{}) ([]databasePartition, error) {
+	parts := make([]databasePartition, 0, len(raw))
+	for i, r := range raw {
+		m := r.(map[string]interface{})
+		t := m["type"].(string)
+
+		var value interface{}
+		switch t {
+		case "tag":
+			if m["tag_name"].(string) == "" {
+				return nil, fmt.Errorf("partition_template.%d: tag_name must be set for a tag part", i)
+			}
+			value = m["tag_name"].(string)
+		case "bucket":
+			if m["tag_name"].(string) == "" || m["number_of_buckets"].(int) <= 0 {
+				return nil, fmt.Errorf("partition_template.%d: tag_name & number_of_buckets must be set for a bucket part", i)
+			}
+			value = databaseBucketPartition{TagName: m["tag_name"].(string), NumberOfBuckets: m["number_of_buckets"].(int)}
+		case "time":
+			if m["time_format"].(string) == "" {
+				return nil, fmt.Errorf("partition_template.%d: time_format must be set for a time part", i)
+			}
+			value = m["time_format"].(string)
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, databasePartition{Type: t, Value: b})
+	}
+	return parts, nil
+}
+
+func flattenDatabasePartitionTemplate(parts []databasePartition) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(parts))
+	for _, p := range parts {
+		m := map[string]interface{}{"type": p.Type}
+		switch p.Type {
+		case "tag":
+			var tag string
+			if err := json.Unmarshal(p.Value, &tag); err != nil {
+				return nil, fmt.Errorf("unexpected tag partition %s: %v", p.Value, err)
+			}
+			m["tag_name"] = tag
+		case "bucket":
+			var bucket databaseBucketPartition
+			if err := json.Unmarshal(p.Value, &bucket); err != nil {
+				return nil, fmt.Errorf("unexpected bucket partition %s: %v", p.Value, err)
+			}
+			m["tag_name"] = bucket.TagName
+			m["number_of_buckets"] = bucket.NumberOfBuckets
+		case "time":
+			var format string
+			if err := json.Unmarshal(p.Value, &format); err != nil {
+				return nil, fmt.Errorf("unexpected time partition %s: %v", p.Value, err)
+			}
+			m["time_format"] = format
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	parts, err := expandDatabasePartitionTemplate(d.Get("partition_template").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating Database (%s)", name)
+	created, err := api.createDatabase(ctx, &database{
+		Name:               name,
+		MaxTables:          d.Get("max_tables").(int),
+		MaxColumnsPerTable: d.Get("max_columns_per_table").(int),
+		RetentionPeriod:    int64(d.Get("retention_period").(int)) * int64(time.Second),
+		PartitionTemplate:  parts,
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Database (%s): %v", name, err)
+	}
+
+	d.SetId(created.Name)
+
+	log.Printf("[INFO] Created Database (%s)", name)
+
+	return resourceDatabaseRead(ctx, d, meta)
+}
+
+func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Database (%s)", id)
+
+	db, err := api.findDatabaseByName(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Database (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Database (%s): %v", id, err)
+	}
+
+	if err := setDatabaseResourceData(d, db); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Database (%s)", id)
+	if _, err := api.updateDatabase(ctx, id, &databaseUpdate{
+		MaxTables:          d.Get("max_tables").(int),
+		MaxColumnsPerTable: d.Get("max_columns_per_table").(int),
+		RetentionPeriod:    int64(d.Get("retention_period").(int)) * int64(time.Second),
+	}); err != nil {
+		return diag.Errorf("unable to update Database (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Database (%s)", id)
+
+	return resourceDatabaseRead(ctx, d, meta)
+}
+
+func resourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Database (%s)", id)
+
+	if err := api.deleteDatabase(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Database (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Database (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Database (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setDatabaseResourceData(d *schema.ResourceData, db *database) error {
+	parts, err := flattenDatabasePartitionTemplate(db.PartitionTemplate)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name", db.Name); err != nil {
+		return err
+	}
+	if err := d.Set("retention_period", int(db.RetentionPeriod/int64(time.Second))); err != nil {
+		return err
+	}
+	if err := d.Set("max_tables", db.MaxTables); err != nil {
+		return err
+	}
+	if err := d.Set("max_columns_per_table", db.MaxColumnsPerTable); err != nil {
+		return err
+	}
+	if err := d.Set("partition_template", parts); err != nil {
+		return err
+	}
+	if err := d.Set("account_id", db.AccountID); err != nil {
+		return err
+	}
+	if err := d.Set("cluster_id", db.ClusterID); err != nil {
+		return err
+	}
+	return nil
+}
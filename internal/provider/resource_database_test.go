@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Cloud Dedicated databases are managed through the Management API, which has no test
+// server, so they are tested against a fake one.
+func testDedicatedServer(t *testing.T) *metaData {
+	var (
+		mu        sync.Mutex
+		databases = []database{}
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v0/accounts/account/clusters/cluster/databases", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer management-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(databases)
+		case http.MethodPost:
+			var db database
+			if err := json.NewDecoder(r.Body).Decode(&db); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			db.AccountID, db.ClusterID = "account", "cluster"
+			if db.MaxTables == 0 {
+				db.MaxTables = 500
+			}
+			if db.MaxColumnsPerTable == 0 {
+				db.MaxColumnsPerTable = 200
+			}
+			if len(db.PartitionTemplate) == 0 {
+				db.PartitionTemplate = []databasePartition{{Type: "time", Value: json.RawMessage(`"%Y-%m-%d"`)}}
+			}
+			databases = append(databases, db)
+			json.NewEncoder(w).Encode(db)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return &metaData{dedicated: newDedicatedClient(srv.URL, "management-token", "account", "cluster")}
+}
+
+func TestResourceDatabaseCreate(t *testing.T) {
+	meta := testDedicatedServer(t)
+
+	template := []interface{}{
+		map[string]interface{}{"type": "tag", "tag_name": "region"},
+		map[string]interface{}{"type": "bucket", "tag_name": "host", "number_of_buckets": 10},
+		map[string]interface{}{"type": "time", "time_format": "%Y-%m"},
+	}
+	d := schema.TestResourceDataRaw(t, resourceDatabase().Schema, map[string]interface{}{
+		"name":               "metrics",
+		"retention_period":   3600,
+		"partition_template": template,
+	})
+	if diags := resourceDatabaseCreate(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "metrics" {
+		t.Errorf("expected ID metrics, got %q", d.Id())
+	}
+	if got := d.Get("retention_period").(int); got != 3600 {
+		t.Errorf("expected retention_period 3600, got %d", got)
+	}
+	if got := d.Get("max_tables").(int); got != 500 {
+		t.Errorf("expected the default max_tables, got %d", got)
+	}
+	expected := []interface{}{
+		map[string]interface{}{"type": "tag", "tag_name": "region", "number_of_buckets": 0, "time_format": ""},
+		map[string]interface{}{"type": "bucket", "tag_name": "host", "number_of_buckets": 10, "time_format": ""},
+		map[string]interface{}{"type": "time", "tag_name": "", "number_of_buckets": 0, "time_format": "%Y-%m"},
+	}
+	if got := d.Get("partition_template"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected partition_template %v, got %v", expected, got)
+	}
+
+	ds := schema.TestResourceDataRaw(t, dataSourceDatabase().Schema, map[string]interface{}{
+		"name": "metrics",
+	})
+	if diags := dataSourceDatabaseRead(context.Background(), ds, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := ds.Get("cluster_id").(string); got != "cluster" {
+		t.Errorf("expected cluster_id cluster, got %q", got)
+	}
+
+	ds = schema.TestResourceDataRaw(t, dataSourceDatabase().Schema, map[string]interface{}{
+		"name": "missing",
+	})
+	if diags := dataSourceDatabaseRead(context.Background(), ds, meta); !diags.HasError() {
+		t.Fatalf("expected an error for a missing database")
+	}
+}
+
+func TestResourceDatabaseWithoutCloudDedicated(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatabase().Schema, map[string]interface{}{
+		"name": "metrics",
+	})
+	if diags := resourceDatabaseCreate(context.Background(), d, &metaData{}); !diags.HasError() {
+		t.Fatalf("expected an error without the cloud_dedicated provider block")
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatabaseToken() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Database Token resource allows you to configure a token of an InfluxDB Cloud Dedicated cluster, granting read or write access to its databases. It is managed through the Management API configured by the `cloud_dedicated` provider block. The token itself is only returned when it is created, so it is unknown for imported tokens.",
+
+		CreateContext: resourceDatabaseTokenCreate,
+		ReadContext:   resourceDatabaseTokenRead,
+		UpdateContext: resourceDatabaseTokenUpdate,
+		DeleteContext: resourceDatabaseTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"description": {
+				Description:      "Description of the token.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"permissions": {
+				Description: "The permissions granted by the token.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Description:      "The permitted action, `read` or `write`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringInSlice([]string{"read", "write"}, false),
+						},
+						"database": {
+							Description:      "Name of the database the permission applies to, or `*` for all databases.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringNotEmpty,
+						},
+					},
+				},
+			},
+			// Computed outputs
+			"access_token": {
+				Description: "The token. It is only known when the token is created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "When the token was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// expandDatabaseTokenPermissions converts the permissions from a configuration to API
+// permissions, sorted so requests are deterministic.
+func expandDatabaseTokenPermissions(v *schema.Set) []databaseTokenPermission {
+	res := make([]databaseTokenPermission, 0, v.Len())
+	for _, raw := range v.List() {
+		m := raw.(map[string]interface{})
+		res = append(res, databaseTokenPermission{
+			Action:   m["action"].(string),
+			Resource: m["database"].(string),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Resource != res[j].Resource {
+			return res[i].Resource < res[j].Resource
+		}
+		return res[i].Action < res[j].Action
+	})
+	return res
+}
+
+func flattenDatabaseTokenPermissions(permissions []databaseTokenPermission) []interface{} {
+	res := make([]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		res = append(res, map[string]interface{}{
+			"action":   p.Action,
+			"database": p.Resource,
+		})
+	}
+	return res
+}
+
+func resourceDatabaseTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	description := d.Get("description").(string)
+
+	log.Printf("[INFO] Creating Database Token (%s)", description)
+	created, err := api.createDatabaseToken(ctx, &databaseToken{
+		Description: description,
+		Permissions: expandDatabaseTokenPermissions(d.Get("permissions").(*schema.Set)),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Database Token (%s): %v", description, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Database Token (%s): <unknown error occurred>", description)
+	}
+
+	d.SetId(created.ID)
+	if err := d.Set("access_token", created.AccessToken); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Created Database Token (%s) (%s)", description, created.ID)
+
+	return resourceDatabaseTokenRead(ctx, d, meta)
+}
+
+func resourceDatabaseTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Database Token (%s)", id)
+
+	t, err := api.findDatabaseTokenByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Database Token (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Database Token (%s): %v", id, err)
+	}
+
+	if err := setDatabaseTokenResourceData(d, t); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Database Token (%s)", id)
+	if _, err := api.updateDatabaseToken(ctx, id, &databaseTokenUpdate{
+		Description: d.Get("description").(string),
+		Permissions: expandDatabaseTokenPermissions(d.Get("permissions").(*schema.Set)),
+	}); err != nil {
+		return diag.Errorf("unable to update Database Token (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Database Token (%s)", id)
+
+	return resourceDatabaseTokenRead(ctx, d, meta)
+}
+
+func resourceDatabaseTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := dedicatedAPI(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Database Token (%s)", id)
+
+	if err := api.deleteDatabaseToken(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Database Token (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Database Token (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Database Token (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setDatabaseTokenResourceData(d *schema.ResourceData, t *databaseToken) error {
+	if err := d.Set("description", t.Description); err != nil {
+		return err
+	}
+	if err := d.Set("permissions", flattenDatabaseTokenPermissions(t.Permissions)); err != nil {
+		return err
+	}
+	if err := d.Set("created_at", t.CreatedAt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceDatabaseTokenCreate(t *testing.T) {
+	var stored databaseToken
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v0/accounts/account/clusters/cluster/tokens", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&stored); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		stored.ID = "token-id"
+		stored.CreatedAt = "2021-06-01T00:00:00Z"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(databaseToken{
+			ID:          stored.ID,
+			Description: stored.Description,
+			Permissions: stored.Permissions,
+			CreatedAt:   stored.CreatedAt,
+			AccessToken: "secret",
+		})
+	})
+	mux.HandleFunc("/api/v0/accounts/account/clusters/cluster/tokens/token-id", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stored)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	meta := &metaData{dedicated: newDedicatedClient(srv.URL, "management-token", "account", "cluster")}
+
+	d := schema.TestResourceDataRaw(t, resourceDatabaseToken().Schema, map[string]interface{}{
+		"description": "metrics writer",
+		"permissions": []interface{}{
+			map[string]interface{}{"action": "write", "database": "metrics"},
+			map[string]interface{}{"action": "read", "database": "metrics"},
+		},
+	})
+	if diags := resourceDatabaseTokenCreate(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(stored.Permissions) != 2 || stored.Permissions[0].Action != "read" || stored.Permissions[1].Action != "write" {
+		t.Errorf("expected sorted read & write permissions, got %v", stored.Permissions)
+	}
+	// the token is only returned on creation, so reads must keep it
+	if got := d.Get("access_token").(string); got != "secret" {
+		t.Errorf("expected access_token secret, got %q", got)
+	}
+	if got := d.Get("permissions").(*schema.Set).Len(); got != 2 {
+		t.Errorf("expected 2 permissions, got %d", got)
+	}
+	if got := d.Get("created_at").(string); got != "2021-06-01T00:00:00Z" {
+		t.Errorf("expected created_at, got %q", got)
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDBRP() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The DBRP resource allows you to configure a InfluxDB2 DBRP mapping, which maps an InfluxDB 1.x database & retention policy to a bucket so that clients using the 1.x compatible write & query endpoints can use InfluxDB2. Mappings refer to the bucket by ID, so renaming the bucket doesn't affect them.",
+
+		CreateContext: withDefaultOrgID(resourceDBRPCreate),
+		ReadContext:   resourceDBRPRead,
+		UpdateContext: resourceDBRPUpdate,
+		DeleteContext: resourceDBRPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDBRPImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"bucket_id": {
+				Description: "ID of the bucket that the database & retention policy map to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"database": {
+				Description:      "The InfluxDB 1.x database name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"retention_policy": {
+				Description:      "The InfluxDB 1.x retention policy name.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the mapping. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"default": {
+				Description: "Whether this mapping is the default retention policy for the database.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the mapping.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDBRPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	database := d.Get("database").(string)
+	rp := d.Get("retention_policy").(string)
+
+	mapping := &dbrp{
+		OrgID:           d.Get("org_id").(string),
+		BucketID:        d.Get("bucket_id").(string),
+		Database:        database,
+		RetentionPolicy: rp,
+		Default:         d.Get("default").(bool),
+	}
+
+	log.Printf("[INFO] Creating DBRP (%s/%s)", database, rp)
+	created, err := api.createDBRP(ctx, mapping)
+	if err != nil {
+		return diag.Errorf("unable to create DBRP (%s/%s): %v", database, rp, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create DBRP (%s/%s): <unknown error occurred>", database, rp)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created DBRP (%s/%s) (%s)", database, rp, created.ID)
+
+	return resourceDBRPRead(ctx, d, meta)
+}
+
+func resourceDBRPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading DBRP (%s)", id)
+
+	mapping, err := api.findDBRPByID(ctx, d.Get("org_id").(string), id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] DBRP (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve DBRP (%s): %v", id, err)
+	}
+
+	if err := setDBRPResourceData(d, mapping); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDBRPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	rp := d.Get("retention_policy").(string)
+	isDefault := d.Get("default").(bool)
+	update := &dbrpUpdate{
+		RetentionPolicy: &rp,
+		Default:         &isDefault,
+	}
+
+	log.Printf("[INFO] Updating DBRP (%s)", id)
+	if _, err := api.updateDBRP(ctx, d.Get("org_id").(string), id, update); err != nil {
+		return diag.Errorf("unable to update DBRP (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated DBRP (%s)", id)
+
+	return resourceDBRPRead(ctx, d, meta)
+}
+
+func resourceDBRPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting DBRP (%s)", id)
+
+	if err := api.deleteDBRP(ctx, d.Get("org_id").(string), id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] DBRP (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete DBRP (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] DBRP (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setDBRPResourceData(d *schema.ResourceData, mapping *dbrp) error {
+	if err := d.Set("id", mapping.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", mapping.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("bucket_id", mapping.BucketID); err != nil {
+		return err
+	}
+	if err := d.Set("database", mapping.Database); err != nil {
+		return err
+	}
+	if err := d.Set("retention_policy", mapping.RetentionPolicy); err != nil {
+		return err
+	}
+	if err := d.Set("default", mapping.Default); err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceDBRPImport imports a mapping using an ID of the form `<org_id>/<dbrp_id>`, since
+// the DBRP API requires the Organization ID for every request.
+func resourceDBRPImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <org_id>/<dbrp_id>", d.Id())
+	}
+
+	if err := d.Set("org_id", parts[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxDBRP(bucketID string, database string, rp string, isDefault bool) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_dbrp" "dbrp" {
+			org_id           = data.influxdb2_organization.initial.id
+			bucket_id        = "%s"
+			database         = "%s"
+			retention_policy = "%s"
+			default          = %t
+		}
+`, testInitialOrg, bucketID, database, rp, isDefault)
+}
+
+func TestAccResourceDBRP(t *testing.T) {
+	db := acctest.RandomWithPrefix("test-db")
+
+	bucketID := testAccInitialBucketID(t)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceDBRPDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxDBRP(bucketID, db, "autogen", true)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_dbrp.dbrp", "database", db),
+					resource.TestCheckResourceAttr("influxdb2_dbrp.dbrp", "retention_policy", "autogen"),
+					resource.TestCheckResourceAttr("influxdb2_dbrp.dbrp", "default", "true"),
+					testAccResourceDBRPExists(provider, "influxdb2_dbrp.dbrp"),
+				),
+			},
+			importStepWithOrgID("influxdb2_dbrp.dbrp"),
+			{
+				//update
+				Config: testConfig(influxDBRP(bucketID, db, "weekly", false)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_dbrp.dbrp", "retention_policy", "weekly"),
+					resource.TestCheckResourceAttr("influxdb2_dbrp.dbrp", "default", "false"),
+					testAccResourceDBRPExists(provider, "influxdb2_dbrp.dbrp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDBRPExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findDBRPByID(context.Background(), rs.Primary.Attributes["org_id"], id); err != nil {
+			return fmt.Errorf("Got an error when reading DBRP %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceDBRPDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_dbrp" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findDBRPByID(context.Background(), rs.Primary.Attributes["org_id"], id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed DBRP %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
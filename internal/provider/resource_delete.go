@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDelete() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Delete resource deletes the points of a bucket matching a predicate in a time range when it is created, e.g. for data purges that need to be reviewed & recorded like any other change. Any change deletes again, use `triggers` to re-run a purge. Destroying the resource only removes it from state: deleted data can't be restored.",
+
+		CreateContext: withDefaultOrgID(resourceDeleteCreate),
+		ReadContext:   resourceDeleteRead,
+		DeleteContext: resourceDeleteDelete,
+		CustomizeDiff: resourceDeleteCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"bucket_id": {
+				Description: "ID of the bucket to delete points from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"predicate": {
+				Description:      "The [delete predicate](https://docs.influxdata.com/influxdb/v2.0/reference/syntax/delete-predicate/) matching the points to delete, e.g. `_measurement=\"users\" AND user_id=\"42\"`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"start": {
+				Description:      "Start of the time range, as an RFC3339 timestamp.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRFC3339,
+			},
+			"stop": {
+				Description:      "End of the time range, as an RFC3339 timestamp.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRFC3339,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the bucket. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values that delete again when changed.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"deleted_at": {
+				Description: "When the points were deleted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDeleteCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("start") || !d.NewValueKnown("stop") {
+		return nil
+	}
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return nil
+	}
+	stop, err := time.Parse(time.RFC3339, d.Get("stop").(string))
+	if err != nil {
+		return nil
+	}
+	if !start.Before(stop) {
+		return fmt.Errorf("start (%s) must be before stop (%s)", d.Get("start"), d.Get("stop"))
+	}
+	return nil
+}
+
+func resourceDeleteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	bucketID := d.Get("bucket_id").(string)
+	predicate := d.Get("predicate").(string)
+
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return diag.Errorf("invalid start: %v", err)
+	}
+	stop, err := time.Parse(time.RFC3339, d.Get("stop").(string))
+	if err != nil {
+		return diag.Errorf("invalid stop: %v", err)
+	}
+
+	log.Printf("[INFO] Deleting points matching (%s) from bucket (%s) between %s and %s", predicate, bucketID, start, stop)
+	if err := api.deletePoints(ctx, d.Get("org_id").(string), bucketID, &deleteRequest{
+		Start:     start,
+		Stop:      stop,
+		Predicate: predicate,
+	}); err != nil {
+		return diag.Errorf("unable to delete points from bucket (%s): %v", bucketID, err)
+	}
+
+	deletedAt := time.Now().UTC()
+	d.SetId(fmt.Sprintf("%s/%d", bucketID, deletedAt.UnixNano()))
+	if err := d.Set("deleted_at", deletedAt.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleted points matching (%s) from bucket (%s)", predicate, bucketID)
+
+	return nil
+}
+
+// resourceDeleteRead does nothing, since a deletion can't be read back.
+func resourceDeleteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDeleteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Deleted points can't be restored, removing Delete (%s) from state only", d.Id())
+
+	return nil
+}
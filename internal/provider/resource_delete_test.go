@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxDelete(bucketID string, measurement string, start string, stop string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_write" "points" {
+			org_id        = data.influxdb2_organization.initial.id
+			bucket_id     = "%s"
+			line_protocol = "%s,host=a value=1\n%s,host=b value=2"
+		}
+		resource "influxdb2_delete" "host_a" {
+			org_id    = data.influxdb2_organization.initial.id
+			bucket_id = "%s"
+			predicate = "_measurement=\"%s\" AND host=\"a\""
+			start     = "%s"
+			stop      = "%s"
+
+			depends_on = [influxdb2_write.points]
+		}
+`, testInitialOrg, bucketID, measurement, measurement, bucketID, measurement, start, stop)
+}
+
+func TestAccResourceDelete(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+	measurement := acctest.RandomWithPrefix("test_delete")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(influxDelete(bucketID, measurement, "2000-01-01T00:00:00Z", "2000-01-01T00:00:00Z")),
+				ExpectError: regexp.MustCompile("must be before stop"),
+			},
+			{
+				Config: testConfig(influxDelete(bucketID, measurement, "2000-01-01T00:00:00Z", "2100-01-01T00:00:00Z")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb2_delete.host_a", "deleted_at"),
+					testAccResourceDeleteCount(provider, measurement, "a", 0),
+					testAccResourceDeleteCount(provider, measurement, "b", 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceDeleteCount checks the number of points left for a host.
+func testAccResourceDeleteCount(testProvider *schema.Provider, measurement string, host string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		api := testProvider.Meta().(*metaData).api
+
+		orgID := s.RootModule().Resources["data.influxdb2_organization.initial"].Primary.ID
+		raw, err := api.query(context.Background(), orgID, fmt.Sprintf(
+			`from(bucket: "%s") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "%s" and r.host == "%s")`,
+			testInitialBucket, measurement, host))
+		if err != nil {
+			return err
+		}
+		rows, err := parseAnnotatedCSV(raw)
+		if err != nil {
+			return err
+		}
+		if len(rows) != count {
+			return fmt.Errorf("expected %d points for host %q, got %v", count, host, rows)
+		}
+		return nil
+	}
+}
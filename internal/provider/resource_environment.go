@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Environment resource creates an Organization, a default bucket and a token that can write to the bucket, as a single unit, e.g. one per microservice environment. When a step of the creation fails, the objects already created are deleted again. A bucket or token deleted outside of Terraform is re-created by the next apply.",
+
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+		CustomizeDiff: resourceEnvironmentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the Organization.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"description": {
+				Description: "Description of the Organization.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"bucket_name": {
+				Description:      "Name of the bucket.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "default",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"retention_period": {
+				Description: "Duration in seconds for how long data is kept in the bucket, `0` for infinite retention.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"token_description": {
+				Description: "Description of the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed outputs
+			"org_id": {
+				Description: "ID of the Organization.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"bucket_id": {
+				Description: "ID of the bucket.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token_id": {
+				Description: "ID of the token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The token that can write to the bucket. It is only known when the token is created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// resourceEnvironmentCustomizeDiff plans the re-creation of a bucket or token that a read
+// found missing. A token is re-created with its bucket, since it only grants access to the
+// bucket by ID. The bucket quota is checked before a bucket is re-created, see checkQuota; a
+// new Environment creates its bucket in a new Organization, which has no buckets yet.
+func resourceEnvironmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	recreate := []string{}
+	if d.Get("bucket_id").(string) == "" {
+		if err := checkQuota(ctx, meta, d.Id(), quotaBucket, 1); err != nil {
+			return err
+		}
+		recreate = append(recreate, "bucket_id", "token_id", "token")
+	} else if d.Get("token_id").(string) == "" {
+		recreate = append(recreate, "token_id", "token")
+	}
+	for _, k := range recreate {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	name := d.Get("name").(string)
+
+	// Check for an existing Organization
+	if _, err := api.findOrganizationByName(ctx, name); err == nil {
+		return diag.Errorf("unable to create Environment (%s) - an Organization with this name already exists", name)
+	} else if !isNotFound(err) {
+		return diag.Errorf("unable to check for presence of an existing Organization (%s): %v", name, err)
+	}
+
+	log.Printf("[INFO] Creating Organization (%s) of Environment", name)
+	description := d.Get("description").(string)
+	org, err := api.createOrganization(ctx, &domain.Organization{
+		Name:        name,
+		Description: &description,
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Organization (%s): %v", name, err)
+	}
+	if org.Id == nil {
+		return diag.Errorf("unable to create Organization (%s): <unknown error occurred>", name)
+	}
+	orgID := *org.Id
+	d.SetId(orgID)
+
+	rb := newRollback(d)
+	rb.add(fmt.Sprintf("Organization (%s)", orgID), func(ctx context.Context) error {
+		return api.deleteOrganization(ctx, orgID)
+	})
+
+	bucketID, err := createEnvironmentBucket(ctx, api, d, orgID)
+	if err != nil {
+		return rb.run(ctx, diag.Errorf("unable to create bucket of Environment (%s): %v", name, err))
+	}
+	d.Set("bucket_id", bucketID)
+	rb.add(fmt.Sprintf("bucket (%s)", bucketID), func(ctx context.Context) error {
+		return api.deleteBucket(ctx, bucketID)
+	})
+
+	auth, err := createEnvironmentToken(ctx, api, d, orgID, bucketID)
+	if err != nil {
+		return rb.run(ctx, diag.Errorf("unable to create token of Environment (%s): %v", name, err))
+	}
+
+	d.Set("token_id", *auth.Id)
+	d.Set("token", auth.Token)
+
+	log.Printf("[INFO] Created Environment (%s) (%s)", name, orgID)
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func createEnvironmentBucket(ctx context.Context, api *apiClient, d *schema.ResourceData, orgID string) (string, error) {
+	name := d.Get("bucket_name").(string)
+
+	log.Printf("[INFO] Creating bucket (%s) in Organization (%s)", name, orgID)
+	b, err := api.createBucket(ctx, &domain.PostBucketRequest{
+		Name:           name,
+		OrgID:          &orgID,
+		RetentionRules: environmentRetentionRules(d),
+	})
+	if err != nil {
+		return "", err
+	}
+	if b.Id == nil {
+		return "", fmt.Errorf("<unknown error occurred>")
+	}
+	return *b.Id, nil
+}
+
+func createEnvironmentToken(ctx context.Context, api *apiClient, d *schema.ResourceData, orgID string, bucketID string) (*domain.Authorization, error) {
+	log.Printf("[INFO] Creating token for bucket (%s)", bucketID)
+	description := d.Get("token_description").(string)
+	auth, err := api.createAuthorization(ctx, &domain.Authorization{
+		AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+			Description: &description,
+		},
+		OrgID: &orgID,
+		Permissions: &[]domain.Permission{{
+			Action: domain.PermissionActionWrite,
+			Resource: domain.Resource{
+				Type:  domain.ResourceTypeBuckets,
+				Id:    &bucketID,
+				OrgID: &orgID,
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if auth.Id == nil {
+		return nil, fmt.Errorf("<unknown error occurred>")
+	}
+	return auth, nil
+}
+
+func environmentRetentionRules(d *schema.ResourceData) domain.RetentionRules {
+	rules := domain.RetentionRules{}
+	if v := d.Get("retention_period").(int); v > 0 {
+		rules = append(rules, domain.RetentionRule{
+			EverySeconds: v,
+			Type:         domain.RetentionRuleTypeExpire,
+		})
+	}
+	return rules
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Environment (%s)", id)
+
+	org, err := api.findOrganizationByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Organization (%s) of Environment not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Organization (%s): %v", id, err)
+	}
+	d.Set("org_id", id)
+	d.Set("name", org.Name)
+	d.Set("description", org.Description)
+
+	var diags diag.Diagnostics
+	if bucketID := d.Get("bucket_id").(string); bucketID != "" {
+		b, err := api.findBucketByID(ctx, bucketID)
+		switch {
+		case isNotFound(err):
+			log.Printf("[WARN] Bucket (%s) of Environment (%s) not found, it will be re-created", bucketID, id)
+			d.Set("bucket_id", "")
+		case err != nil:
+			return diag.Errorf("unable to retrieve bucket (%s): %v", bucketID, err)
+		default:
+			d.Set("bucket_name", b.Name)
+			d.Set("retention_period", bucketRetentionPeriod(*b))
+			diags = checkBucketCardinality(ctx, meta, id, bucketID)
+		}
+	}
+
+	if tokenID := d.Get("token_id").(string); tokenID != "" {
+		auth, err := api.findAuthorizationByID(ctx, tokenID)
+		switch {
+		case isNotFound(err):
+			log.Printf("[WARN] Token (%s) of Environment (%s) not found, it will be re-created", tokenID, id)
+			d.Set("token_id", "")
+			d.Set("token", "")
+		case err != nil:
+			return diag.Errorf("unable to retrieve token (%s): %v", tokenID, err)
+		default:
+			d.Set("token_description", auth.Description)
+		}
+	}
+
+	return diags
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Environment (%s)", id)
+
+	if d.HasChange("description") {
+		org, err := api.findOrganizationByID(ctx, id)
+		if err != nil {
+			return diag.Errorf("unable to retrieve Organization (%s): %v", id, err)
+		}
+		description := d.Get("description").(string)
+		org.Description = &description
+		if _, err := api.updateOrganization(ctx, id, org); err != nil {
+			return diag.Errorf("unable to update Organization (%s): %v", id, err)
+		}
+	}
+
+	bucketID := d.Get("bucket_id").(string)
+	if bucketID == "" {
+		var err error
+		if bucketID, err = createEnvironmentBucket(ctx, api, d, id); err != nil {
+			return diag.Errorf("unable to re-create bucket of Environment (%s): %v", id, err)
+		}
+		d.Set("bucket_id", bucketID)
+	} else if d.HasChanges("bucket_name", "retention_period") {
+		b, err := api.findBucketByID(ctx, bucketID)
+		if err != nil {
+			return diag.Errorf("unable to retrieve bucket (%s): %v", bucketID, err)
+		}
+		b.Name = d.Get("bucket_name").(string)
+		b.RetentionRules = environmentRetentionRules(d)
+		if _, err := api.updateBucket(ctx, bucketID, b); err != nil {
+			return diag.Errorf("unable to update bucket (%s): %v", bucketID, err)
+		}
+	}
+
+	if d.Get("token_id").(string) == "" {
+		// a token re-created with its bucket replaces the token of the previous bucket
+		if old, _ := d.GetChange("token_id"); old.(string) != "" {
+			if err := api.deleteAuthorization(ctx, old.(string)); err != nil && !isNotFound(err) {
+				return diag.Errorf("unable to delete token (%s): %v", old, err)
+			}
+		}
+		auth, err := createEnvironmentToken(ctx, api, d, id, bucketID)
+		if err != nil {
+			return diag.Errorf("unable to re-create token of Environment (%s): %v", id, err)
+		}
+		d.Set("token_id", *auth.Id)
+		d.Set("token", auth.Token)
+	} else if d.HasChange("token_description") {
+		tokenID := d.Get("token_id").(string)
+		description := d.Get("token_description").(string)
+		if _, err := api.updateAuthorization(ctx, tokenID, &domain.AuthorizationUpdateRequest{Description: &description}); err != nil {
+			return diag.Errorf("unable to update token (%s): %v", tokenID, err)
+		}
+	}
+
+	log.Printf("[INFO] Updated Environment (%s)", id)
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Environment (%s)", id)
+
+	if tokenID := d.Get("token_id").(string); tokenID != "" {
+		if err := api.deleteAuthorization(ctx, tokenID); err != nil && !isNotFound(err) {
+			return diag.Errorf("unable to delete token (%s): %v", tokenID, err)
+		}
+	}
+	if bucketID := d.Get("bucket_id").(string); bucketID != "" {
+		if err := api.deleteBucket(ctx, bucketID); err != nil && !isNotFound(err) {
+			return diag.Errorf("unable to delete bucket (%s): %v", bucketID, err)
+		}
+	}
+	if err := api.deleteOrganization(ctx, id); err != nil && !isNotFound(err) {
+		return diag.Errorf("unable to delete Organization (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Deleted Environment (%s)", id)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxEnvironment(name string, bucketName string, retention int) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_environment" "env" {
+			name              = "%s"
+			description       = "test environment"
+			bucket_name       = "%s"
+			retention_period  = %d
+			token_description = "writes of %s"
+		}
+`, name, bucketName, retention, name)
+}
+
+func TestAccResourceEnvironment(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-env")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceEnvironmentDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxEnvironment(name, "metrics", 3600)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb2_environment.env", "org_id", "influxdb2_environment.env", "id"),
+					resource.TestCheckResourceAttr("influxdb2_environment.env", "bucket_name", "metrics"),
+					resource.TestCheckResourceAttr("influxdb2_environment.env", "retention_period", "3600"),
+					resource.TestCheckResourceAttrSet("influxdb2_environment.env", "bucket_id"),
+					resource.TestCheckResourceAttrSet("influxdb2_environment.env", "token_id"),
+					resource.TestCheckResourceAttrSet("influxdb2_environment.env", "token"),
+				),
+			},
+			{
+				//update
+				Config: testConfig(influxEnvironment(name, "metrics-v2", 0)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_environment.env", "bucket_name", "metrics-v2"),
+					resource.TestCheckResourceAttr("influxdb2_environment.env", "retention_period", "0"),
+				),
+			},
+			{
+				// a bucket deleted outside of Terraform is re-created, with a new token
+				PreConfig: func() {
+					api := provider.Meta().(*metaData).api
+					org, err := api.findOrganizationByName(context.Background(), name)
+					if err != nil {
+						t.Fatal(err)
+					}
+					buckets, err := api.findBuckets(context.Background(), *org.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, b := range buckets {
+						if b.Name == "metrics-v2" {
+							if err := api.deleteBucket(context.Background(), *b.Id); err != nil {
+								t.Fatal(err)
+							}
+						}
+					}
+				},
+				Config: testConfig(influxEnvironment(name, "metrics-v2", 0)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceEnvironmentExists(provider, "influxdb2_environment.env"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEnvironmentExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findBucketByID(context.Background(), rs.Primary.Attributes["bucket_id"]); err != nil {
+			return fmt.Errorf("Got an error when reading bucket of Environment %q: %v", rs.Primary.ID, err)
+		}
+		if _, err := api.findAuthorizationByID(context.Background(), rs.Primary.Attributes["token_id"]); err != nil {
+			return fmt.Errorf("Got an error when reading token of Environment %q: %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceEnvironmentDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_environment" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findOrganizationByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Environment %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
+
+// The rollback of a partial create is tested against a fake server whose token creation fails.
+func TestResourceEnvironmentCreateRollback(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/orgs":
+			w.Write([]byte(`{"orgs":[]}`))
+		case "POST /api/v2/orgs":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"0000000000000001","name":"env"}`))
+		case "POST /api/v2/buckets":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"0000000000000002","name":"default","retentionRules":[]}`))
+		case "POST /api/v2/authorizations":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code":"internal error","message":"boom"}`))
+		case "DELETE /api/v2/buckets/0000000000000002", "DELETE /api/v2/orgs/0000000000000001":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	d := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]interface{}{
+		"name": "env",
+	})
+	diags := resourceEnvironmentCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "unable to create token") {
+		t.Fatalf("expected only the token creation error, got: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no ID after a rolled back create, got %q", d.Id())
+	}
+
+	expected := []string{
+		"GET /api/v2/orgs",
+		"POST /api/v2/orgs",
+		"POST /api/v2/buckets",
+		"POST /api/v2/authorizations",
+		"DELETE /api/v2/buckets/0000000000000002",
+		"DELETE /api/v2/orgs/0000000000000001",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+}
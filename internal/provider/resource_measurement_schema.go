@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMeasurementSchema() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Measurement Schema resource allows you to configure the schema of a measurement in an InfluxDB Cloud bucket with an `explicit` schema type. Columns can only be added: removing a column or changing its type is rejected at plan time. The API can't delete schemas, so destroying the resource only removes it from state.",
+
+		CreateContext: resourceMeasurementSchemaCreate,
+		ReadContext:   resourceMeasurementSchemaRead,
+		UpdateContext: resourceMeasurementSchemaUpdate,
+		DeleteContext: resourceMeasurementSchemaDelete,
+		CustomizeDiff: resourceMeasurementSchemaCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMeasurementSchemaImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"bucket_id": {
+				Description: "ID of the bucket, which must have an `explicit` schema type.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:      "Name of the measurement.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"columns": {
+				Description: "The columns of the measurement. There must be exactly one `timestamp` column and at least one `field` column.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:      "Name of the column.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringNotEmpty,
+						},
+						"type": {
+							Description:      "Type of the column: `tag`, `field` or `timestamp`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringInSlice([]string{"tag", "field", "timestamp"}, false),
+						},
+						"data_type": {
+							Description:      "Data type of a `field` column: `integer`, `float`, `boolean`, `string` or `unsigned`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateStringInSlice([]string{"integer", "float", "boolean", "string", "unsigned"}, false),
+						},
+					},
+				},
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the measurement schema.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"org_id": {
+				Description: "ID of the Organization that owns the bucket.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceMeasurementSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	bucketID := d.Get("bucket_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating Measurement Schema (%s) in bucket (%s)", name, bucketID)
+	created, err := api.createMeasurementSchema(ctx, bucketID, &measurementSchema{
+		Name:    name,
+		Columns: expandMeasurementSchemaColumns(d.Get("columns").([]interface{})),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Measurement Schema (%s) in bucket (%s): %v", name, bucketID, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Measurement Schema (%s) in bucket (%s): <unknown error occurred>", name, bucketID)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Measurement Schema (%s) (%s)", name, created.ID)
+
+	return resourceMeasurementSchemaRead(ctx, d, meta)
+}
+
+func resourceMeasurementSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+	bucketID := d.Get("bucket_id").(string)
+
+	log.Printf("[INFO] Reading Measurement Schema (%s)", id)
+
+	s, err := api.findMeasurementSchemaByID(ctx, bucketID, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Measurement Schema (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Measurement Schema (%s): %v", id, err)
+	}
+
+	if err := setMeasurementSchemaResourceData(d, s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkBucketCardinality(ctx, meta, d.Get("org_id").(string), bucketID)
+}
+
+func resourceMeasurementSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Measurement Schema (%s)", id)
+	if _, err := api.updateMeasurementSchema(ctx, d.Get("bucket_id").(string), id, expandMeasurementSchemaColumns(d.Get("columns").([]interface{}))); err != nil {
+		return diag.Errorf("unable to update Measurement Schema (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Measurement Schema (%s)", id)
+
+	return resourceMeasurementSchemaRead(ctx, d, meta)
+}
+
+func resourceMeasurementSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Measurement Schemas can't be deleted, removing Measurement Schema (%s) from state only", d.Id())
+
+	return nil
+}
+
+// resourceMeasurementSchemaCustomizeDiff rejects updates the API can't make: every existing
+// column must be kept with the same type.
+func resourceMeasurementSchemaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("columns") {
+		return nil
+	}
+
+	o, n := d.GetChange("columns")
+	return checkMeasurementSchemaColumnsAdditive(
+		expandMeasurementSchemaColumns(o.([]interface{})),
+		expandMeasurementSchemaColumns(n.([]interface{})),
+	)
+}
+
+func checkMeasurementSchemaColumnsAdditive(old []measurementSchemaColumn, new []measurementSchemaColumn) error {
+	byName := make(map[string]measurementSchemaColumn, len(new))
+	for _, c := range new {
+		byName[c.Name] = c
+	}
+
+	for _, c := range old {
+		n, ok := byName[c.Name]
+		if !ok {
+			return fmt.Errorf("column %q can't be removed from the measurement schema, columns can only be added", c.Name)
+		}
+		if n.Type != c.Type || n.DataType != c.DataType {
+			return fmt.Errorf("the type of column %q can't be changed, columns can only be added", c.Name)
+		}
+	}
+	return nil
+}
+
+func expandMeasurementSchemaColumns(list []interface{}) []measurementSchemaColumn {
+	res := make([]measurementSchemaColumn, 0, len(list))
+	for _, raw := range list {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		res = append(res, measurementSchemaColumn{
+			Name:     m["name"].(string),
+			Type:     m["type"].(string),
+			DataType: m["data_type"].(string),
+		})
+	}
+	return res
+}
+
+func flattenMeasurementSchemaColumns(columns []measurementSchemaColumn) []interface{} {
+	res := make([]interface{}, 0, len(columns))
+	for _, c := range columns {
+		res = append(res, map[string]interface{}{
+			"name":      c.Name,
+			"type":      c.Type,
+			"data_type": c.DataType,
+		})
+	}
+	return res
+}
+
+func setMeasurementSchemaResourceData(d *schema.ResourceData, s *measurementSchema) error {
+	if err := d.Set("id", s.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("bucket_id", s.BucketID); err != nil {
+		return err
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("columns", flattenMeasurementSchemaColumns(s.Columns)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceMeasurementSchemaImport imports a schema using an ID of the form
+// `<bucket_id>/<schema_id>`, since the API requires the bucket ID for every request.
+func resourceMeasurementSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <bucket_id>/<schema_id>", d.Id())
+	}
+
+	if err := d.Set("bucket_id", parts[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+// Measurement schemas need an InfluxDB Cloud bucket with an explicit schema type, which the
+// docker-compose test server doesn't support, so only the plan time checks are tested.
+func TestMeasurementSchemaColumnsAdditive(t *testing.T) {
+	existing := []measurementSchemaColumn{
+		{Name: "time", Type: "timestamp"},
+		{Name: "host", Type: "tag"},
+		{Name: "usage", Type: "field", DataType: "float"},
+	}
+
+	cases := []struct {
+		name    string
+		columns []measurementSchemaColumn
+		err     string
+	}{
+		{"unchanged", existing, ""},
+		{"added & reordered", []measurementSchemaColumn{existing[2], existing[0], {Name: "region", Type: "tag"}, existing[1]}, ""},
+		{"removed", existing[:2], `column "usage" can't be removed`},
+		{"type changed", []measurementSchemaColumn{existing[0], existing[1], {Name: "usage", Type: "field", DataType: "integer"}}, `the type of column "usage" can't be changed`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkMeasurementSchemaColumnsAdditive(existing, c.columns)
+			if c.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", c.err, err)
+			}
+		})
+	}
+}
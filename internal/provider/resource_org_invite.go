@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	inviteStatusPending  = "pending"
+	inviteStatusExpired  = "expired"
+	inviteStatusAccepted = "accepted"
+)
+
+func resourceOrgInvite() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Org Invite resource allows you to invite someone by email to join an InfluxDB Cloud Organization, which is how Cloud adds members. Destroying the resource withdraws a pending invite, or removes the user from the Organization once the invite was accepted. Only available on InfluxDB Cloud.",
+
+		CreateContext: withDefaultOrgID(resourceOrgInviteCreate),
+		ReadContext:   resourceOrgInviteRead,
+		DeleteContext: resourceOrgInviteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrgInviteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"email": {
+				Description:      "Email address to send the invite to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization to invite the user to. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Description:      "Role of the user in the Organization once the invite is accepted, `member` or `owner`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "member",
+				ValidateDiagFunc: validateStringInSlice([]string{"member", "owner"}, false),
+			},
+			// Computed outputs
+			"status": {
+				Description: "State of the invite: `pending`, `expired` or `accepted`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "When a pending invite expires.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description: "ID of the user that accepted the invite.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceOrgInviteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	email := d.Get("email").(string)
+
+	log.Printf("[INFO] Creating Invite (%s) to Organization (%s)", email, orgID)
+	created, err := api.createInvite(ctx, orgID, &invite{
+		Email: email,
+		Role:  d.Get("role").(string),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Invite (%s) to Organization (%s): %v", email, orgID, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Invite (%s) to Organization (%s): <unknown error occurred>", email, orgID)
+	}
+
+	d.SetId(orgID + "/" + created.ID)
+
+	log.Printf("[INFO] Created Invite (%s) to Organization (%s)", email, orgID)
+
+	return resourceOrgInviteRead(ctx, d, meta)
+}
+
+func resourceOrgInviteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID, inviteID, err := parseOrgInviteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	email := d.Get("email").(string)
+	role := d.Get("role").(string)
+
+	log.Printf("[INFO] Reading Invite (%s) to Organization (%s)", inviteID, orgID)
+
+	inv, err := api.findInviteByID(ctx, orgID, inviteID)
+	if err == nil {
+		status := inviteStatusPending
+		expiresAt := ""
+		if inv.ExpiresAt != nil {
+			expiresAt = inv.ExpiresAt.Format(time.RFC3339)
+			if inv.ExpiresAt.Before(time.Now()) {
+				status = inviteStatusExpired
+			}
+		}
+		return setOrgInviteResourceData(d, orgID, inv.Email, inv.Role, status, expiresAt, "")
+	}
+	if !isNotFound(err) {
+		return diag.Errorf("unable to retrieve Invite (%s) to Organization (%s): %v", inviteID, orgID, err)
+	}
+
+	// Accepted invites are removed, so look for the user in the Organization instead. Cloud
+	// users are named by their email address.
+	users, err := api.findResourceUsers(ctx, "/api/v2/orgs/"+orgID, role+"s")
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Organization (%s) not found, removing Invite (%s) from state", orgID, inviteID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve %ss of Organization (%s): %v", role, orgID, err)
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Name, email) {
+			return setOrgInviteResourceData(d, orgID, email, role, inviteStatusAccepted, "", u.ID)
+		}
+	}
+
+	log.Printf("[WARN] Invite (%s) to Organization (%s) was withdrawn or the user was removed, removing from state", inviteID, orgID)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceOrgInviteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID, inviteID, err := parseOrgInviteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if userID := d.Get("user_id").(string); d.Get("status").(string) == inviteStatusAccepted && userID != "" {
+		role := d.Get("role").(string)
+
+		log.Printf("[INFO] Removing user (%s) as %s of Organization (%s)", userID, role, orgID)
+		if err := api.removeResourceUser(ctx, "/api/v2/orgs/"+orgID, role+"s", userID); err != nil {
+			if isNotFound(err) {
+				log.Printf("[WARN] user (%s) or Organization (%s) not found, so no action was taken", userID, orgID)
+				return nil
+			}
+			return diag.Errorf("unable to remove user (%s) as %s of Organization (%s): %v", userID, role, orgID, err)
+		}
+		log.Printf("[INFO] Removed user (%s) as %s of Organization (%s), removing from state", userID, role, orgID)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Invite (%s) to Organization (%s)", inviteID, orgID)
+
+	if err := api.deleteInvite(ctx, orgID, inviteID); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Invite (%s) not found, so no action was taken", inviteID)
+			return nil
+		}
+		return diag.Errorf("unable to delete Invite (%s) to Organization (%s): %v", inviteID, orgID, err)
+	}
+
+	log.Printf("[INFO] Invite (%s) deleted, removing from state", inviteID)
+
+	return nil
+}
+
+func setOrgInviteResourceData(d *schema.ResourceData, orgID string, email string, role string, status string, expiresAt string, userID string) diag.Diagnostics {
+	if err := d.Set("org_id", orgID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role", role); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expires_at", expiresAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_id", userID); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func parseOrgInviteID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <org_id>/<invite_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// resourceOrgInviteImport imports a pending invite using an ID of the form
+// `<org_id>/<invite_id>`. Accepted invites no longer exist and can't be imported.
+func resourceOrgInviteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	orgID, inviteID, err := parseOrgInviteID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := meta.(*metaData).api.findInviteByID(ctx, orgID, inviteID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import Invite (%s) to Organization (%s): %v", inviteID, orgID, err)
+	}
+
+	if err := d.Set("org_id", orgID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("email", inv.Email); err != nil {
+		return nil, err
+	}
+	if err := d.Set("role", inv.Role); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
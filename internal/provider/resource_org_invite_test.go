@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The invites API only exists on InfluxDB Cloud, so it is tested against a fake server
+// instead of the docker-compose test server.
+func testInviteServer(t *testing.T, invites string, members string) *metaData {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2private/orgs/0000000000000001/invites", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(invites))
+	})
+	mux.HandleFunc("/api/v2/orgs/0000000000000001/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(members))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return &metaData{api: newAPIClient(srv.URL, "token")}
+}
+
+func TestResourceOrgInviteReadStatus(t *testing.T) {
+	cases := []struct {
+		name    string
+		invites string
+		members string
+		id      string
+		status  string
+		userID  string
+	}{
+		{"pending", `{"invites":[{"id":"0000000000000002","email":"a@example.com","role":"member","expiresAt":"2999-01-01T00:00:00Z"}]}`, `{"users":[]}`, "0000000000000001/0000000000000002", inviteStatusPending, ""},
+		{"expired", `{"invites":[{"id":"0000000000000002","email":"a@example.com","role":"member","expiresAt":"2000-01-01T00:00:00Z"}]}`, `{"users":[]}`, "0000000000000001/0000000000000002", inviteStatusExpired, ""},
+		{"accepted", `{"invites":[]}`, `{"users":[{"id":"0000000000000003","name":"A@example.com","role":"member"}]}`, "0000000000000001/0000000000000002", inviteStatusAccepted, "0000000000000003"},
+		{"withdrawn", `{"invites":[]}`, `{"users":[]}`, "", "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta := testInviteServer(t, c.invites, c.members)
+
+			d := schema.TestResourceDataRaw(t, resourceOrgInvite().Schema, map[string]interface{}{
+				"org_id": "0000000000000001",
+				"email":  "a@example.com",
+			})
+			d.SetId("0000000000000001/0000000000000002")
+
+			if diags := resourceOrgInviteRead(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != c.id {
+				t.Errorf("expected ID %q, got %q", c.id, d.Id())
+			}
+			if c.id == "" {
+				return
+			}
+			if got := d.Get("status").(string); got != c.status {
+				t.Errorf("expected status %q, got %q", c.status, got)
+			}
+			if got := d.Get("user_id").(string); got != c.userID {
+				t.Errorf("expected user_id %q, got %q", c.userID, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var orgOwner = userAssociation{
+	itemType:       "Organization",
+	idAttribute:    "org_id",
+	collectionPath: "/api/v2/orgs",
+	role:           "owners",
+}
+
+func resourceOrgOwner() *schema.Resource {
+	return resourceUserAssociation(orgOwner, "The Org Owner resource allows you to make a user an owner of an InfluxDB2 Organization. Removing the resource revokes the ownership, without deleting the user.")
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxOrgOwner(orgName string, userID string) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_organization" "org" {
+			name = "%s"
+		}
+		resource "influxdb2_org_owner" "owner" {
+			org_id  = influxdb2_organization.org.id
+			user_id = "%s"
+		}
+`, orgName, userID)
+}
+
+func TestAccResourceOrgOwner(t *testing.T) {
+	orgName := acctest.RandomWithPrefix("test-org")
+	userName := acctest.RandomWithPrefix("test-user")
+
+	userID := testAccUser(t, userName)
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceUserAssociationDestroy(t, provider, "influxdb2_org_owner", orgOwner),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxOrgOwner(orgName, userID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_org_owner.owner", "user_id", userID),
+					resource.TestCheckResourceAttr("influxdb2_org_owner.owner", "user_name", userName),
+					testAccResourceUserAssociationExists(provider, "influxdb2_org_owner.owner", orgOwner),
+				),
+			},
+			importStep("influxdb2_org_owner.owner"),
+		},
+	})
+}
+
+func testAccResourceUserAssociationExists(testProvider *schema.Provider, name string, a userAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		objectID := rs.Primary.Attributes[a.idAttribute]
+		userID := rs.Primary.Attributes["user_id"]
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findResourceUser(context.Background(), a.collectionPath+"/"+objectID, a.role, userID); err != nil {
+			return fmt.Errorf("Got an error when reading %s of %s %q: %v", a.role, a.itemType, objectID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceUserAssociationDestroy(t *testing.T, testProvider *schema.Provider, resourceType string, a userAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			objectID := rs.Primary.Attributes[a.idAttribute]
+			userID := rs.Primary.Attributes["user_id"]
+
+			// the object itself is usually destroyed too, which also removes the user
+			_, err := api.findResourceUser(context.Background(), a.collectionPath+"/"+objectID, a.role, userID)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find user %q in destroyed %s of %s %q: %v", userID, a.role, a.itemType, objectID, err)
+			}
+		}
+		return nil
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -48,15 +48,14 @@ func resourceOrganization() *schema.Resource {
 }
 
 func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*metaData).client
-	orgsAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	name := d.Get("name").(string)
 
 	// Check for an existing Organization
-	_, err := orgsAPI.FindOrganizationByName(ctx, name)
+	_, err := api.findOrganizationByName(ctx, name)
 	if err != nil {
-		if !strings.Contains(err.Error(), "not found") {
+		if !isNotFound(err) {
 			return diag.Errorf("unable to check for presence of an existing Organization (%s): %v", name, err)
 		}
 		log.Printf("[INFO] Organization (%s) not found, proceeding with create", name)
@@ -65,14 +64,14 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	description := d.Get("description").(string)
-	org := *&domain.Organization{
+	org := domain.Organization{
 		Name:        name,
 		Description: &description,
 	}
 
 	log.Printf("[INFO] Creating Organization (%s)", name)
-	returnedOrg, err := orgsAPI.CreateOrganization(ctx, &org)
-	if err != nil {
+	var returnedOrg domain.Organization
+	if err := api.doJSON(ctx, http.MethodPost, "/api/v2/orgs", nil, org, &returnedOrg); err != nil {
 		return diag.Errorf("unable to create Organization (%s): %v", name, err)
 	}
 
@@ -87,7 +86,7 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, met
 	log.Printf("[INFO] Created Organization (%s) (%s)", name, id)
 
 	// Get the updated Organization
-	updatedOrg, err := orgsAPI.FindOrganizationByID(ctx, id)
+	updatedOrg, err := api.findOrganizationByID(ctx, id)
 	if err != nil {
 		return diag.Errorf("unable to retrieve Organization (%s) (%s): %v", name, id, err)
 	}
@@ -100,16 +99,15 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*metaData).client
-	orgsAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	id := d.Id()
 
 	log.Printf("[INFO] Reading Organization (%s)", id)
 
-	org, err := orgsAPI.FindOrganizationByID(ctx, id)
+	org, err := api.findOrganizationByID(ctx, id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Organization (%s) not found, removing from state", id)
 			d.SetId("")
 			return nil
@@ -126,16 +124,15 @@ func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*metaData).client
-	orgsAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	id := d.Id()
 
 	log.Printf("[INFO] Reading Organization (%s)", id)
 
-	org, err := orgsAPI.FindOrganizationByID(ctx, id)
+	org, err := api.findOrganizationByID(ctx, id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Organization (%s) not found, removing from state", id)
 			d.SetId("")
 			return nil
@@ -150,14 +147,14 @@ func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, met
 	org.Description = &description
 
 	log.Printf("[INFO] Updating Organization (%s)", id)
-	updatedOrg, err := orgsAPI.UpdateOrganization(ctx, org)
-	if err != nil {
+	var updatedOrg domain.Organization
+	if err := api.doJSON(ctx, http.MethodPatch, "/api/v2/orgs/"+id, nil, org, &updatedOrg); err != nil {
 		return diag.Errorf("unable to update Organization (%s): %v", id, err)
 	}
 
 	log.Printf("[INFO] Updated Organization (%s)", id)
 
-	if err := setOrganizationResourceData(d, updatedOrg); err != nil {
+	if err := setOrganizationResourceData(d, &updatedOrg); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -165,16 +162,15 @@ func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*metaData).client
-	orgsAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	id := d.Id()
 
 	log.Printf("[INFO] Deleting Organization (%s)", id)
 
-	err := orgsAPI.DeleteOrganizationWithID(ctx, id)
+	err := api.doJSON(ctx, http.MethodDelete, "/api/v2/orgs/"+id, nil, nil, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Organization (%s) not found, so no action was taken", id)
 			return nil
 		}
@@ -214,13 +210,12 @@ func setOrganizationResourceData(d *schema.ResourceData, org *domain.Organizatio
 // resourceOrganizationImport implements the logic necessary to import an un-tracked
 // (by Terraform) Organization resource into Terraform state.
 func resourceOrganizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*metaData).client
-	orgsAPI := client.OrganizationsAPI()
+	api := meta.(*metaData).api
 
 	id := d.Id()
 
 	// Get the imported Organization
-	importedOrg, err := orgsAPI.FindOrganizationByID(ctx, id)
+	importedOrg, err := api.findOrganizationByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("unable to import Organization (%s) : %v", id, err)
 	}
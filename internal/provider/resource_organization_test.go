@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -71,9 +70,9 @@ func testAccResourceOrganizationExists(testProvider *schema.Provider, name strin
 			return fmt.Errorf("No ID is set")
 		}
 
-		client := testProvider.Meta().(*metaData).client
+		api := testProvider.Meta().(*metaData).api
 
-		if _, err := client.OrganizationsAPI().FindOrganizationByID(context.Background(), id); err != nil {
+		if _, err := api.findOrganizationByID(context.Background(), id); err != nil {
 			return fmt.Errorf("Got an error when reading Organization %q: %v", id, err)
 		}
 
@@ -86,15 +85,15 @@ func testAccCheckResourceOrganizationDestroy(t *testing.T, testProvider *schema.
 		if testProvider.Meta() == nil {
 			t.Fatal("got nil provider metadata")
 		}
-		client := testProvider.Meta().(*metaData).client
+		api := testProvider.Meta().(*metaData).api
 
 		for _, rs := range s.RootModule().Resources {
 			switch rs.Type {
 			case "influxdb2_organization":
 				id := rs.Primary.ID
 
-				_, err := client.OrganizationsAPI().FindOrganizationByID(context.Background(), id)
-				if !strings.Contains(err.Error(), "not found") {
+				_, err := api.findOrganizationByID(context.Background(), id)
+				if !isNotFound(err) {
 					//return fmt.Errorf("didn't get a 404 when reading destroyed account %q: %v", id, err)
 					return fmt.Errorf("Was able to find destroyed Organization %q: %v", id, err)
 				}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRemote() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Remote resource allows you to configure a connection from an InfluxDB2 OSS instance to a remote InfluxDB2 instance, e.g. InfluxDB Cloud, which replications use as their target. Requires InfluxDB2 OSS 2.1 or later. The API never returns the remote token, so changes made to it outside of Terraform can't be detected.",
+
+		CreateContext: withDefaultOrgID(resourceRemoteCreate),
+		ReadContext:   resourceRemoteRead,
+		UpdateContext: resourceRemoteUpdate,
+		DeleteContext: resourceRemoteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the connection.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"remote_url": {
+				Description:      "URL of the remote InfluxDB2 instance.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"remote_org_id": {
+				Description: "ID of the Organization on the remote instance.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"remote_token": {
+				Description: "An API token for the remote instance. It is write-only: the value in state is always the last value written by Terraform.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the local Organization that owns the connection. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the connection.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"allow_insecure_tls": {
+				Description: "Whether to skip verification of the remote instance's TLS certificate.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the connection.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceRemoteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating Remote (%s)", name)
+	created, err := api.createRemote(ctx, &remote{
+		OrgID:            d.Get("org_id").(string),
+		Name:             name,
+		Description:      d.Get("description").(string),
+		RemoteURL:        d.Get("remote_url").(string),
+		RemoteOrgID:      d.Get("remote_org_id").(string),
+		RemoteAPIToken:   d.Get("remote_token").(string),
+		AllowInsecureTLS: d.Get("allow_insecure_tls").(bool),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Remote (%s): %v", name, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Remote (%s): <unknown error occurred>", name)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Remote (%s) (%s)", name, created.ID)
+
+	return resourceRemoteRead(ctx, d, meta)
+}
+
+func resourceRemoteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Remote (%s)", id)
+
+	r, err := api.findRemoteByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Remote (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Remote (%s): %v", id, err)
+	}
+
+	if err := setRemoteResourceData(d, r); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRemoteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	update := &remoteUpdate{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		RemoteURL:        d.Get("remote_url").(string),
+		RemoteOrgID:      d.Get("remote_org_id").(string),
+		AllowInsecureTLS: d.Get("allow_insecure_tls").(bool),
+	}
+	if d.HasChange("remote_token") {
+		token := d.Get("remote_token").(string)
+		update.RemoteAPIToken = &token
+	}
+
+	log.Printf("[INFO] Updating Remote (%s)", id)
+	if _, err := api.updateRemote(ctx, id, update); err != nil {
+		return diag.Errorf("unable to update Remote (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Remote (%s)", id)
+
+	return resourceRemoteRead(ctx, d, meta)
+}
+
+func resourceRemoteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Remote (%s)", id)
+
+	if err := api.deleteRemote(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Remote (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Remote (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Remote (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setRemoteResourceData(d *schema.ResourceData, r *remote) error {
+	if err := d.Set("id", r.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", r.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", r.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", r.Description); err != nil {
+		return err
+	}
+	if err := d.Set("remote_url", r.RemoteURL); err != nil {
+		return err
+	}
+	if err := d.Set("remote_org_id", r.RemoteOrgID); err != nil {
+		return err
+	}
+	if err := d.Set("allow_insecure_tls", r.AllowInsecureTLS); err != nil {
+		return err
+	}
+	return nil
+}
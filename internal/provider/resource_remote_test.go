@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxRemote(name string, description string, token string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_remote" "remote" {
+			org_id        = data.influxdb2_organization.initial.id
+			name          = "%s"
+			description   = "%s"
+			remote_url    = "https://cloud.example.com"
+			remote_org_id = "0000000000000001"
+			remote_token  = "%s"
+		}
+`, testInitialOrg, name, description, token)
+}
+
+func TestAccResourceRemote(t *testing.T) {
+	testAccSkipUnlessEndpoint(t, "/api/v2/remotes", url.Values{"orgID": []string{testAccInitialOrgID(t)}})
+
+	name := acctest.RandomWithPrefix("test-remote")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceRemoteDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxRemote(name, "first", "token-1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_remote.remote", "name", name),
+					resource.TestCheckResourceAttr("influxdb2_remote.remote", "remote_org_id", "0000000000000001"),
+					testAccResourceRemoteExists(provider, "influxdb2_remote.remote"),
+				),
+			},
+			importStep("influxdb2_remote.remote", "remote_token"),
+			{
+				//update
+				Config: testConfig(influxRemote(name, "second", "token-2")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_remote.remote", "description", "second"),
+					testAccResourceRemoteExists(provider, "influxdb2_remote.remote"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRemoteExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findRemoteByID(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading Remote %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceRemoteDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_remote" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findRemoteByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Remote %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRestore() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Restore resource restores a backup of `influx backup` or the `influxdb2_backup` resource to an InfluxDB OSS instance when it is created, e.g. to re-create an environment from a backup. Since restoring again would overwrite data written in the meantime, changing a created restore fails the plan unless `allow_rerun` is set. Restores require an operator token. Destroying the resource only removes it from state: restored data is kept.",
+
+		CreateContext: resourceRestoreCreate,
+		ReadContext:   resourceRestoreRead,
+		UpdateContext: resourceRestoreUpdate,
+		DeleteContext: resourceRestoreDelete,
+		CustomizeDiff: resourceRestoreCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"path": {
+				Description:      "Path of the local directory of the backup files.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"manifest": {
+				Description: "Name of the manifest file of the backup to restore, in `path`. Defaults to the latest backup.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"full": {
+				Description: "Whether to replace all the metadata of the instance, including its users & tokens, with those of the backup. Otherwise, only the buckets of the backup are restored, and they must not exist. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"bucket_names": {
+				Description:   "Names of the buckets of the backup to restore. Defaults to all buckets.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"full"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allow_rerun": {
+				Description: "Whether a change of the other arguments may restore again. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			// Computed outputs
+			"bucket_ids": {
+				Description: "IDs of the restored buckets by name. Buckets get new IDs unless `full` is set.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"restored_at": {
+				Description: "When the backup was restored.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceRestoreCustomizeDiff fails the plan when a created restore would be replaced, i.e.
+// restored again, unless allow_rerun is set.
+func resourceRestoreCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("allow_rerun").(bool) {
+		return nil
+	}
+	for _, k := range []string{"path", "manifest", "full", "bucket_names"} {
+		if d.HasChange(k) {
+			return fmt.Errorf("the backup of Restore (%s) was already restored, changing %s would restore it again: set allow_rerun to do so", d.Id(), k)
+		}
+	}
+	return nil
+}
+
+func resourceRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api.streaming()
+
+	dir := d.Get("path").(string)
+	name, manifest, err := readBackupManifest(dir, d.Get("manifest").(string))
+	if err != nil {
+		return diag.Errorf("unable to read the backup of (%s): %v", dir, err)
+	}
+
+	var diags diag.Diagnostics
+	bucketIDs := map[string]interface{}{}
+
+	log.Printf("[INFO] Restoring backup (%s) of (%s)", name, dir)
+	if d.Get("full").(bool) {
+		restored, err := api.restoreKV(ctx, dir, manifest.KV)
+		if err != nil {
+			return diag.Errorf("unable to restore the KV store of backup (%s): %v", name, err)
+		}
+		if restored.token != api.token {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The token of the provider was replaced",
+				Detail:   "The restored metadata has another operator token, so the token of the provider can't be used anymore.",
+			})
+			api = restored
+		}
+		if manifest.SQL != nil {
+			if err := api.restoreSQL(ctx, dir, *manifest.SQL); err != nil {
+				return append(diags, diag.Errorf("unable to restore the SQL store of backup (%s): %v", name, err)...)
+			}
+		}
+		// the KV store has the shards, so their data is restored to the same IDs
+		for _, b := range manifest.Buckets {
+			for id, f := range backupShardFiles(b) {
+				if err := api.restoreShard(ctx, dir, id, f); err != nil {
+					return append(diags, diag.Errorf("unable to restore shard %d of bucket (%s): %v", id, b.BucketName, err)...)
+				}
+			}
+			bucketIDs[b.BucketName] = b.BucketID
+		}
+	} else {
+		buckets := manifest.Buckets
+		if names := d.Get("bucket_names").(*schema.Set); names.Len() > 0 {
+			buckets = nil
+			for _, b := range manifest.Buckets {
+				if names.Contains(b.BucketName) {
+					buckets = append(buckets, b)
+				}
+			}
+			if len(buckets) < names.Len() {
+				return diag.Errorf("unable to restore backup (%s): some of the buckets %v are not in the backup", name, names.List())
+			}
+		}
+		for _, b := range buckets {
+			restored, err := api.restoreBucket(ctx, dir, b)
+			if err != nil {
+				return diag.Errorf("unable to restore bucket (%s) of backup (%s): %v", b.BucketName, name, err)
+			}
+			bucketIDs[b.BucketName] = restored.ID
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", dir, name))
+	if err := d.Set("manifest", name); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("bucket_ids", bucketIDs); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("restored_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	log.Printf("[INFO] Restored backup (%s) of (%s)", name, dir)
+
+	return diags
+}
+
+// resourceRestoreRead does nothing, since a restore can't be read back.
+func resourceRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceRestoreUpdate does nothing, since only allow_rerun can be updated.
+func resourceRestoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Restored data is kept, removing Restore (%s) from state only", d.Id())
+
+	return nil
+}
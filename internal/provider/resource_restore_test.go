@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testRestoreServer records the restore requests made to it, with their uncompressed bodies.
+// Restored buckets get the ID 0000000000000004, and shard 1 becomes shard 11.
+func testRestoreServer(t *testing.T) (*httptest.Server, map[string]string) {
+	var mu sync.Mutex
+	calls := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			body = gz
+		}
+		b, _ := ioutil.ReadAll(body)
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization")] = string(b)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/restore/kv":
+			w.Write([]byte(`{"token":"restored-token"}`))
+		case "/api/v2/restore/bucketMetadata":
+			w.Write([]byte(`{"id":"0000000000000004","name":"metrics","shardMappings":[{"oldId":1,"newId":11}]}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, calls
+}
+
+func testBackup(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "backup")
+	if _, err := newAPIClient(testBackupServer(t).URL, "token").backup(context.Background(), dir, "0000000000000002"); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestResourceRestoreCreate(t *testing.T) {
+	dir := testBackup(t)
+	srv, calls := testRestoreServer(t)
+
+	d := schema.TestResourceDataRaw(t, resourceRestore().Schema, map[string]interface{}{
+		"path":         dir,
+		"bucket_names": []interface{}{"metrics"},
+	})
+	if diags := resourceRestoreCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("bucket_ids").(map[string]interface{}); !reflect.DeepEqual(got, map[string]interface{}{"metrics": "0000000000000004"}) {
+		t.Errorf("expected the new ID of the metrics bucket, got %v", got)
+	}
+	if got, ok := calls["POST /api/v2/restore/shards/11 Token token"]; !ok || got != "shard 1" {
+		t.Errorf("expected the data of shard 1 restored to shard 11, got calls %v", calls)
+	}
+	if _, ok := calls["POST /api/v2/restore/kv Token token"]; ok {
+		t.Errorf("expected the KV store not to be restored, got calls %v", calls)
+	}
+}
+
+func TestResourceRestoreCreateFull(t *testing.T) {
+	dir := testBackup(t)
+	srv, calls := testRestoreServer(t)
+
+	d := schema.TestResourceDataRaw(t, resourceRestore().Schema, map[string]interface{}{
+		"path": dir,
+		"full": true,
+	})
+	diags := resourceRestoreCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Summary != "The token of the provider was replaced" {
+		t.Errorf("expected a warning about the replaced token, got %v", diags)
+	}
+
+	expected := map[string]string{
+		"POST /api/v2/restore/kv Token token":                "bolt",
+		"POST /api/v2/restore/sql Token restored-token":      "sqlite",
+		"POST /api/v2/restore/shards/1 Token restored-token": "shard 1",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+	if got := d.Get("bucket_ids").(map[string]interface{}); !reflect.DeepEqual(got, map[string]interface{}{"metrics": "0000000000000002"}) {
+		t.Errorf("expected the ID of the metrics bucket, got %v", got)
+	}
+}
+
+func TestResourceRestoreCreateMissingBucket(t *testing.T) {
+	dir := testBackup(t)
+	srv, calls := testRestoreServer(t)
+
+	d := schema.TestResourceDataRaw(t, resourceRestore().Schema, map[string]interface{}{
+		"path":         dir,
+		"bucket_names": []interface{}{"metrics", "missing"},
+	})
+	if diags := resourceRestoreCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")}); !diags.HasError() {
+		t.Fatal("expected an error for a bucket missing from the backup")
+	}
+	if len(calls) != 0 {
+		t.Errorf("expected nothing to be restored, got calls %v", calls)
+	}
+}
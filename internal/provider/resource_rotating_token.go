@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// defaultTokenResourceTypes are the resource types of InfluxDB OSS 2.7, granted by
+// all-access & operator tokens on servers that don't list their resource types.
+var defaultTokenResourceTypes = []domain.ResourceType{
+	domain.ResourceTypeAuthorizations,
+	domain.ResourceTypeBuckets,
+	domain.ResourceTypeChecks,
+	domain.ResourceTypeDashboards,
+	domain.ResourceTypeDbrp,
+	domain.ResourceTypeDocuments,
+	domain.ResourceTypeLabels,
+	domain.ResourceTypeNotificationEndpoints,
+	domain.ResourceTypeNotificationRules,
+	domain.ResourceTypeOrgs,
+	domain.ResourceTypeScrapers,
+	domain.ResourceTypeSecrets,
+	domain.ResourceTypeSources,
+	domain.ResourceTypeTasks,
+	domain.ResourceTypeTelegrafs,
+	domain.ResourceTypeUsers,
+	domain.ResourceTypeVariables,
+	domain.ResourceTypeViews,
+	"notebooks",
+	"annotations",
+	"remotes",
+	"replications",
+	resourceTypeInstance,
+}
+
+// resourceTypeInstance grants the setup of the server, so only operator tokens have it.
+const resourceTypeInstance = domain.ResourceType("instance")
+
+// tokenResourceTypes returns the resource types granted by all-access & operator tokens:
+// those the server lists, since newer releases keep adding types, or else the defaults.
+func tokenResourceTypes(ctx context.Context, api *apiClient) ([]domain.ResourceType, error) {
+	types, err := api.findResourceTypes(ctx)
+	if isNotFound(err) {
+		return defaultTokenResourceTypes, nil
+	}
+	return types, err
+}
+
+func resourceRotatingToken() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Rotating Token resource allows you to configure an all-access or operator token that is replaced whenever `rotation_keepers` change, e.g. with a timestamp of the `time_rotating` resource of the `time` provider. Replacing the token creates a new one and deletes the old one, so scheduled credential rotation is a normal apply. Use `create_before_destroy` so that the new token exists before the old one is deleted.",
+
+		CreateContext: withDefaultOrgID(resourceRotatingTokenCreate),
+		ReadContext:   resourceRotatingTokenRead,
+		UpdateContext: resourceRotatingTokenUpdate,
+		DeleteContext: resourceRotatingTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"access": {
+				Description:      "The access granted by the token: `all-access` for read & write access to all resources of the Organization, or `operator` for read & write access to all resources of all Organizations. The token is granted every resource type listed by the server, or those of InfluxDB OSS 2.7 when the server doesn't list them.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{"all-access", "operator"}, false),
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the token. An all-access token is restricted to the resources of the Organization. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"user_id": {
+				Description: "ID of the user the token belongs to. Defaults to the user of the provider's token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"rotation_keepers": {
+				Description: "Arbitrary values that, when changed, replace the token with a new one.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed outputs
+			"token": {
+				Description: "The token. It is only known when the token is created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": {
+				Description: "The status of the token, `active` or `inactive`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// tokenPermissions returns the permissions of an all-access token of an Organization, or of
+// an operator token when orgID is empty, over the given resource types.
+func tokenPermissions(orgID string, types []domain.ResourceType) []domain.Permission {
+	var permissions []domain.Permission
+	for _, t := range types {
+		resource := domain.Resource{Type: t}
+		switch {
+		case orgID == "":
+		case t == resourceTypeInstance:
+			continue
+		case t == domain.ResourceTypeOrgs:
+			resource.Id = &orgID
+		default:
+			resource.OrgID = &orgID
+		}
+		for _, action := range []domain.PermissionAction{domain.PermissionActionRead, domain.PermissionActionWrite} {
+			// an all-access token can't change its own Organization
+			if orgID != "" && t == domain.ResourceTypeOrgs && action == domain.PermissionActionWrite {
+				continue
+			}
+			permissions = append(permissions, domain.Permission{Action: action, Resource: resource})
+		}
+	}
+	return permissions
+}
+
+func resourceRotatingTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	access := d.Get("access").(string)
+	description := d.Get("description").(string)
+
+	types, err := tokenResourceTypes(ctx, api)
+	if err != nil {
+		return diag.Errorf("unable to list the resource types of the server: %v", err)
+	}
+	permissions := tokenPermissions(orgID, types)
+	if access == "operator" {
+		permissions = tokenPermissions("", types)
+	}
+
+	auth := &domain.Authorization{
+		AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+			Description: &description,
+		},
+		OrgID:       &orgID,
+		Permissions: &permissions,
+	}
+	if userID := d.Get("user_id").(string); userID != "" {
+		auth.UserID = &userID
+	}
+
+	log.Printf("[INFO] Creating %s Token (%s)", access, orgID)
+	created, err := api.createAuthorization(ctx, auth)
+	if err != nil {
+		return diag.Errorf("unable to create %s Token (%s): %v", access, orgID, err)
+	}
+	if created.Id == nil {
+		return diag.Errorf("unable to create %s Token (%s): <unknown error occurred>", access, orgID)
+	}
+
+	d.SetId(*created.Id)
+	if err := d.Set("token", stringValue(created.Token)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Created %s Token (%s) (%s)", access, orgID, *created.Id)
+
+	return resourceRotatingTokenRead(ctx, d, meta)
+}
+
+func resourceRotatingTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Token (%s)", id)
+
+	auth, err := api.findAuthorizationByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Token (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Token (%s): %v", id, err)
+	}
+
+	if err := setRotatingTokenResourceData(d, auth); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRotatingTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+	description := d.Get("description").(string)
+
+	log.Printf("[INFO] Updating Token (%s)", id)
+	if _, err := api.updateAuthorization(ctx, id, &domain.AuthorizationUpdateRequest{
+		Description: &description,
+	}); err != nil {
+		return diag.Errorf("unable to update Token (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Token (%s)", id)
+
+	return resourceRotatingTokenRead(ctx, d, meta)
+}
+
+func resourceRotatingTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Token (%s)", id)
+
+	if err := api.deleteAuthorization(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Token (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Token (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Token (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setRotatingTokenResourceData(d *schema.ResourceData, auth *domain.Authorization) error {
+	if err := d.Set("org_id", stringValue(auth.OrgID)); err != nil {
+		return err
+	}
+	if err := d.Set("user_id", stringValue(auth.UserID)); err != nil {
+		return err
+	}
+	if err := d.Set("description", stringValue(auth.Description)); err != nil {
+		return err
+	}
+	if auth.Status != nil {
+		if err := d.Set("status", string(*auth.Status)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
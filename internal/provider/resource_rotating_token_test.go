@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+func influxRotatingToken(rotation string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_rotating_token" "token" {
+			org_id      = data.influxdb2_organization.initial.id
+			access      = "all-access"
+			description = "rotated token"
+
+			rotation_keepers = {
+				rotation = "%s"
+			}
+
+			lifecycle {
+				create_before_destroy = true
+			}
+		}
+`, testInitialOrg, rotation)
+}
+
+func TestAccResourceRotatingToken(t *testing.T) {
+	var provider *schema.Provider
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceRotatingTokenDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxRotatingToken("2021-06")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_rotating_token.token", "status", "active"),
+					resource.TestCheckResourceAttrSet("influxdb2_rotating_token.token", "token"),
+					testAccResourceRotatingTokenExists(provider, "influxdb2_rotating_token.token", &firstID),
+				),
+			},
+			{
+				//rotate
+				Config: testConfig(influxRotatingToken("2021-07")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb2_rotating_token.token", "token"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["influxdb2_rotating_token.token"].Primary.ID
+						if id == firstID {
+							return fmt.Errorf("expected a new token after rotation, got %q again", id)
+						}
+						_, err := provider.Meta().(*metaData).api.findAuthorizationByID(context.Background(), firstID)
+						if !isNotFound(err) {
+							return fmt.Errorf("expected the rotated token %q to be deleted: %v", firstID, err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestTokenPermissions(t *testing.T) {
+	orgID := "0000000000000001"
+
+	for _, p := range tokenPermissions(orgID, defaultTokenResourceTypes) {
+		if p.Resource.Type == resourceTypeInstance {
+			t.Errorf("expected no instance permission for an all-access token, got %v", p)
+		}
+		if p.Resource.Type == domain.ResourceTypeOrgs {
+			if p.Action != domain.PermissionActionRead || stringValue(p.Resource.Id) != orgID {
+				t.Errorf("expected only read access to the Organization itself, got %v", p)
+			}
+			continue
+		}
+		if stringValue(p.Resource.OrgID) != orgID {
+			t.Errorf("expected all-access permissions restricted to the Organization, got %v", p)
+		}
+	}
+
+	operator := tokenPermissions("", defaultTokenResourceTypes)
+	if len(operator) != 2*len(defaultTokenResourceTypes) {
+		t.Errorf("expected read & write permissions of all types, got %d", len(operator))
+	}
+	for _, p := range operator {
+		if p.Resource.OrgID != nil || p.Resource.Id != nil {
+			t.Errorf("expected unrestricted operator permissions, got %v", p)
+		}
+	}
+}
+
+func TestTokenResourceTypes(t *testing.T) {
+	listed := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !listed {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not found","message":"path not found"}`))
+			return
+		}
+		w.Write([]byte(`["authorizations","buckets","subscriptions"]`))
+	}))
+	defer srv.Close()
+	api := newAPIClient(srv.URL, "token")
+
+	types, err := tokenResourceTypes(context.Background(), api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []domain.ResourceType{"authorizations", "buckets", "subscriptions"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("expected the types of the server %v, got %v", want, types)
+	}
+
+	listed = false
+	types, err = tokenResourceTypes(context.Background(), api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(types, defaultTokenResourceTypes) {
+		t.Errorf("expected the default types, got %v", types)
+	}
+}
+
+func testAccResourceRotatingTokenExists(testProvider *schema.Provider, name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*id = rs.Primary.ID
+		if *id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findAuthorizationByID(context.Background(), *id); err != nil {
+			return fmt.Errorf("Got an error when reading token %q: %v", *id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceRotatingTokenDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_rotating_token" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findAuthorizationByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed token %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceScript() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Script resource allows you to configure an InfluxDB Cloud invokable script, which can be run through the API with parameters passed as `params`. The Organization is the one of the provider's token. Only available on InfluxDB Cloud.",
+
+		CreateContext: resourceScriptCreate,
+		ReadContext:   resourceScriptRead,
+		UpdateContext: resourceScriptUpdate,
+		DeleteContext: resourceScriptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the script, unique in the Organization.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"script": {
+				Description:      "The script body. Differences in line endings, trailing whitespace and leading or trailing blank lines are ignored.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+				DiffSuppressFunc: suppressEquivalentFlux,
+			},
+			// Optional Inputs
+			"description": {
+				Description: "The description of the script.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"language": {
+				Description:      "The language of the script: `flux`, `sql` or `influxql`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "flux",
+				ValidateDiagFunc: validateStringInSlice([]string{"flux", "sql", "influxql"}, false),
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the script.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"org_id": {
+				Description: "ID of the Organization that owns the script.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The URL to invoke the script.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"parameters": {
+				Description: "The names of the parameters used by the script, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}, createdUpdatedSchema("script")),
+	}
+}
+
+func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating Script (%s)", name)
+	created, err := api.createScript(ctx, &scriptCreate{
+		Name:        name,
+		Description: d.Get("description").(string),
+		Script:      d.Get("script").(string),
+		Language:    d.Get("language").(string),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Script (%s): %v", name, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Script (%s): <unknown error occurred>", name)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Script (%s) (%s)", name, created.ID)
+
+	return resourceScriptRead(ctx, d, meta)
+}
+
+func resourceScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Script (%s)", id)
+
+	s, err := api.findScriptByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Script (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Script (%s): %v", id, err)
+	}
+
+	var diags diag.Diagnostics
+	if old, ok := d.GetOk("script"); ok {
+		if diff := fluxDiff(old.(string), s.Script); diff != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Script (%s) was changed outside of Terraform", id),
+				Detail:   diff,
+			})
+		}
+	}
+
+	params, err := api.findScriptParams(ctx, id)
+	if err != nil {
+		return diag.Errorf("unable to retrieve the parameters of Script (%s): %v", id, err)
+	}
+
+	if err := setScriptResourceData(d, s, params); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Script (%s)", id)
+	if _, err := api.updateScript(ctx, id, &scriptUpdate{
+		Description: d.Get("description").(string),
+		Script:      d.Get("script").(string),
+	}); err != nil {
+		return diag.Errorf("unable to update Script (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Script (%s)", id)
+
+	return resourceScriptRead(ctx, d, meta)
+}
+
+func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Script (%s)", id)
+
+	if err := api.deleteScript(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Script (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Script (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Script (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setScriptResourceData(d *schema.ResourceData, s *script, params []string) error {
+	if err := d.Set("id", s.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", s.Description); err != nil {
+		return err
+	}
+	if err := d.Set("script", normalizeFlux(s.Script)); err != nil {
+		return err
+	}
+	if err := d.Set("language", s.Language); err != nil {
+		return err
+	}
+	if err := d.Set("url", s.URL); err != nil {
+		return err
+	}
+	if err := d.Set("parameters", params); err != nil {
+		return err
+	}
+	if err := d.Set("created_at", s.CreatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", s.UpdatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("created_timestamp", s.CreatedAt.Unix()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_timestamp", s.UpdatedAt.Unix()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxScript(name string, description string, script string) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_script" "script" {
+			name        = "%s"
+			description = "%s"
+			script      = %q
+		}
+`, name, description, script)
+}
+
+func TestResourceScriptReadDrift(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/params") {
+			w.Write([]byte(`{"params":{}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"0000000000000002","name":"s","script":"buckets()\n  |> limit(n: 1)","language":"flux"}`))
+	}))
+	defer srv.Close()
+	md := &metaData{api: newAPIClient(srv.URL, "token")}
+
+	for _, c := range []struct {
+		script  string
+		warning bool
+	}{
+		{"buckets()\n  |> limit(n: 1)", false},
+		{"buckets()\n  |> limit(n: 10)", true},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceScript().Schema, map[string]interface{}{"name": "s", "script": c.script})
+		d.SetId("0000000000000002")
+
+		diags := resourceScriptRead(context.Background(), d, md)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if !c.warning && len(diags) != 0 {
+			t.Errorf("expected no warning for an unchanged script, got: %v", diags)
+		}
+		if c.warning && (len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "limit(n: 1)")) {
+			t.Errorf("expected a warning with the diff of the script, got: %v", diags)
+		}
+	}
+}
+
+func TestAccResourceScript(t *testing.T) {
+	testAccSkipUnlessEndpoint(t, "/api/v2/scripts", nil)
+
+	name := acctest.RandomWithPrefix("test-script")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceScriptDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxScript(name, "first", `from(bucket: params.bucket) |> range(start: -1h)`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_script.script", "name", name),
+					resource.TestCheckResourceAttr("influxdb2_script.script", "language", "flux"),
+					resource.TestCheckResourceAttr("influxdb2_script.script", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("influxdb2_script.script", "parameters.0", "bucket"),
+					testAccResourceScriptExists(provider, "influxdb2_script.script"),
+				),
+			},
+			importStep("influxdb2_script.script"),
+			{
+				//update
+				Config: testConfig(influxScript(name, "second", `from(bucket: params.bucket) |> range(start: params.start)`)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_script.script", "description", "second"),
+					resource.TestCheckResourceAttr("influxdb2_script.script", "parameters.#", "2"),
+					resource.TestCheckResourceAttr("influxdb2_script.script", "parameters.1", "start"),
+					testAccResourceScriptExists(provider, "influxdb2_script.script"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceScriptExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findScriptByID(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading Script %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceScriptDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_script" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findScriptByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Script %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSecret() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Secret resource allows you to store a key/value secret in an InfluxDB2 Organization's secret store, for use by Flux tasks via `secrets.get()`. The API never returns secret values, so changes made to the value outside of Terraform can't be detected.",
+
+		CreateContext: withDefaultOrgID(resourceSecretCreate),
+		ReadContext:   resourceSecretRead,
+		UpdateContext: resourceSecretUpdate,
+		DeleteContext: resourceSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecretImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"key": {
+				Description:      "The key of the secret.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"value": {
+				Description: "The value of the secret. It is write-only: the value in state is always the last value written by Terraform.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the secret. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	key := d.Get("key").(string)
+
+	// Check for an existing secret, PATCH would silently overwrite it
+	keys, err := api.findSecretKeys(ctx, orgID)
+	if err != nil {
+		return diag.Errorf("unable to check for presence of an existing Secret (%s) in Organization (%s): %v", key, orgID, err)
+	}
+	for _, k := range keys {
+		if k == key {
+			return diag.Errorf("unable to create Secret (%s) - a Secret with this key already exists in Organization (%s); see resource documentation for influxdb2_secret for instructions on how to add an already existing Secret to the state", key, orgID)
+		}
+	}
+
+	log.Printf("[INFO] Creating Secret (%s) in Organization (%s)", key, orgID)
+	if err := api.putSecrets(ctx, orgID, map[string]string{key: d.Get("value").(string)}); err != nil {
+		return diag.Errorf("unable to create Secret (%s) in Organization (%s): %v", key, orgID, err)
+	}
+
+	d.SetId(secretID(orgID, key))
+
+	log.Printf("[INFO] Created Secret (%s) in Organization (%s)", key, orgID)
+
+	return resourceSecretRead(ctx, d, meta)
+}
+
+func resourceSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[INFO] Reading Secret (%s) in Organization (%s)", key, orgID)
+
+	keys, err := api.findSecretKeys(ctx, orgID)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Organization (%s) not found, removing Secret (%s) from state", orgID, key)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Secrets of Organization (%s): %v", orgID, err)
+	}
+
+	for _, k := range keys {
+		if k == key {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Secret (%s) not found in Organization (%s), removing from state", key, orgID)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[INFO] Updating Secret (%s) in Organization (%s)", key, orgID)
+	if err := api.putSecrets(ctx, orgID, map[string]string{key: d.Get("value").(string)}); err != nil {
+		return diag.Errorf("unable to update Secret (%s) in Organization (%s): %v", key, orgID, err)
+	}
+
+	log.Printf("[INFO] Updated Secret (%s) in Organization (%s)", key, orgID)
+
+	return resourceSecretRead(ctx, d, meta)
+}
+
+func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+	key := d.Get("key").(string)
+
+	log.Printf("[INFO] Deleting Secret (%s) from Organization (%s)", key, orgID)
+
+	if err := api.deleteSecrets(ctx, orgID, key); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Secret (%s) not found in Organization (%s), so no action was taken", key, orgID)
+			return nil
+		}
+		return diag.Errorf("unable to delete Secret (%s) from Organization (%s): %v", key, orgID, err)
+	}
+
+	log.Printf("[INFO] Secret (%s) deleted from Organization (%s), removing from state", key, orgID)
+
+	return nil
+}
+
+func secretID(orgID string, key string) string {
+	return orgID + "/" + key
+}
+
+// resourceSecretImport imports a secret using an ID of the form `<org_id>/<key>`. The value
+// can't be imported, it is only known once Terraform writes it.
+func resourceSecretImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <org_id>/<key>", d.Id())
+	}
+
+	if err := d.Set("org_id", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("key", parts[1]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxSecret(orgName string, value string) string {
+	return fmt.Sprintf(`
+		resource "influxdb2_organization" "org" {
+			name = "%s"
+		}
+		resource "influxdb2_secret" "secret" {
+			org_id = influxdb2_organization.org.id
+			key    = "SLACK_TOKEN"
+			value  = "%s"
+		}
+`, orgName, value)
+}
+
+func TestAccResourceSecret(t *testing.T) {
+	org := acctest.RandomWithPrefix("test-org")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxSecret(org, "first")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_secret.secret", "key", "SLACK_TOKEN"),
+					resource.TestCheckResourceAttr("influxdb2_secret.secret", "value", "first"),
+					testAccResourceSecretExists(provider, "influxdb2_secret.secret"),
+				),
+			},
+			importStep("influxdb2_secret.secret", "value"),
+			{
+				//update
+				Config: testConfig(influxSecret(org, "second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_secret.secret", "value", "second"),
+					testAccResourceSecretExists(provider, "influxdb2_secret.secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecretDefaultOrg(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "influxdb2" {
+						host  = "%s"
+						token = "%s"
+						org   = "%s"
+					}
+					resource "influxdb2_secret" "secret" {
+						key   = "DEFAULT_ORG_TOKEN"
+						value = "first"
+					}
+				`, testHost, testToken, testInitialOrg),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb2_secret.secret", "org_id"),
+					testAccResourceSecretExists(provider, "influxdb2_secret.secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecretExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		orgID := rs.Primary.Attributes["org_id"]
+		key := rs.Primary.Attributes["key"]
+
+		api := testProvider.Meta().(*metaData).api
+
+		keys, err := api.findSecretKeys(context.Background(), orgID)
+		if err != nil {
+			return fmt.Errorf("Got an error when reading Secrets of Organization %q: %v", orgID, err)
+		}
+		for _, k := range keys {
+			if k == key {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Secret %q not found in Organization %q", key, orgID)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSetup() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Setup resource onboards a fresh InfluxDB2 OSS instance, creating the initial user, Organization, bucket & operator token. Setup can only happen once per instance, so destroying the resource only removes it from state. To configure the provider before the instance exists, choose the operator token up front with `token` and use the same value for the provider's `token`.",
+
+		CreateContext: resourceSetupCreate,
+		ReadContext:   resourceSetupRead,
+		DeleteContext: resourceSetupDelete,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"username": {
+				Description:      "Name of the initial user.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"org": {
+				Description:      "Name of the initial Organization.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"bucket": {
+				Description:      "Name of the initial bucket.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"password": {
+				Description: "Password of the initial user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"retention_period": {
+				Description: "Retention period of the initial bucket in seconds, `0` keeps data forever.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     0,
+			},
+			"token": {
+				Description: "Operator token to create. When omitted, InfluxDB2 generates one.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			// Computed outputs
+			"user_id": {
+				Description: "ID of the initial user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"org_id": {
+				Description: "ID of the initial Organization.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"bucket_id": {
+				Description: "ID of the initial bucket.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSetupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	allowed, err := api.isSetupAllowed(ctx)
+	if err != nil {
+		return diag.Errorf("unable to check whether InfluxDB2 can be setup: %v", err)
+	}
+	if !allowed {
+		return diag.Errorf("unable to setup InfluxDB2 - the instance has already been setup")
+	}
+
+	org := d.Get("org").(string)
+
+	log.Printf("[INFO] Setting up InfluxDB2 with Organization (%s)", org)
+	resp, err := api.setup(ctx, &setupRequest{
+		Username:               d.Get("username").(string),
+		Password:               d.Get("password").(string),
+		Org:                    org,
+		Bucket:                 d.Get("bucket").(string),
+		RetentionPeriodSeconds: int64(d.Get("retention_period").(int)),
+		Token:                  d.Get("token").(string),
+	})
+	if err != nil {
+		return diag.Errorf("unable to setup InfluxDB2: %v", err)
+	}
+	if resp.Org == nil || resp.Org.Id == nil || resp.Auth == nil || resp.Auth.Token == nil {
+		return diag.Errorf("unable to setup InfluxDB2: <unknown error occurred>")
+	}
+
+	d.SetId(*resp.Org.Id)
+
+	if err := d.Set("org_id", *resp.Org.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token", *resp.Auth.Token); err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.User != nil {
+		if err := d.Set("user_id", stringValue(resp.User.Id)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if resp.Bucket != nil {
+		if err := d.Set("bucket_id", stringValue(resp.Bucket.Id)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Setup InfluxDB2 with Organization (%s) (%s)", org, d.Id())
+
+	return resourceSetupRead(ctx, d, meta)
+}
+
+// resourceSetupRead keeps the state as is: onboarding is a one-off action, and the objects
+// it created are managed independently afterwards, e.g. renamed by other resources.
+func resourceSetupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceSetupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] InfluxDB2 setup can't be undone, removing Setup (%s) from state only", d.Id())
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The test server is onboarded by docker-compose, so only the refusal to setup an instance
+// twice can be tested against it.
+func TestAccResourceSetupAlreadySetup(t *testing.T) {
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(`
+					resource "influxdb2_setup" "setup" {
+						username = "admin"
+						password = "super-secret"
+						org      = "another-org"
+						bucket   = "another-bucket"
+					}
+				`),
+				ExpectError: regexp.MustCompile("already been setup"),
+			},
+		},
+	})
+}
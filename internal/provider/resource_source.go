@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSource() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Source resource allows you to configure a legacy, Chronograf style InfluxDB2 source, e.g. an InfluxDB 1.x server referenced by dashboards. The API never returns `password`, `token` and `shared_secret`, so changes made to them outside of Terraform can't be detected.",
+
+		CreateContext: withDefaultOrgID(resourceSourceCreate),
+		ReadContext:   resourceSourceRead,
+		UpdateContext: resourceSourceUpdate,
+		DeleteContext: resourceSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the source.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the source. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:      "Type of the source: `v1`, `v2` or `self`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "v1",
+				ValidateDiagFunc: validateStringInSlice([]string{"v1", "v2", "self"}, false),
+			},
+			"url": {
+				Description: "URL of the source.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default": {
+				Description: "Whether the source is the default source.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"insecure_skip_verify": {
+				Description: "Whether to skip verification of the source's TLS certificate.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"telegraf": {
+				Description: "Name of the database Telegraf writes to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"username": {
+				Description: "Username to connect to the source.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "Password to connect to the source. It is write-only: the value in state is always the last value written by Terraform.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Description: "Token to connect to the source. It is write-only: the value in state is always the last value written by Terraform.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"shared_secret": {
+				Description: "JWT shared secret to connect to the source. It is write-only: the value in state is always the last value written by Terraform.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"meta_url": {
+				Description: "URL of the meta node of an InfluxDB Enterprise source.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default_rp": {
+				Description: "Default retention policy used to query the source.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"languages": {
+				Description: "The query languages supported by the source.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating Source (%s)", name)
+	created, err := api.createSource(ctx, &source{
+		OrgID:              d.Get("org_id").(string),
+		Default:            d.Get("default").(bool),
+		Name:               name,
+		Type:               d.Get("type").(string),
+		URL:                d.Get("url").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		Telegraf:           d.Get("telegraf").(string),
+		Username:           d.Get("username").(string),
+		Password:           d.Get("password").(string),
+		Token:              d.Get("token").(string),
+		SharedSecret:       d.Get("shared_secret").(string),
+		MetaURL:            d.Get("meta_url").(string),
+		DefaultRP:          d.Get("default_rp").(string),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Source (%s): %v", name, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Source (%s): <unknown error occurred>", name)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Source (%s) (%s)", name, created.ID)
+
+	return resourceSourceRead(ctx, d, meta)
+}
+
+func resourceSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Source (%s)", id)
+
+	s, err := api.findSourceByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Source (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Source (%s): %v", id, err)
+	}
+
+	if err := setSourceResourceData(d, s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	update := &sourceUpdate{
+		Default:            d.Get("default").(bool),
+		Name:               d.Get("name").(string),
+		Type:               d.Get("type").(string),
+		URL:                d.Get("url").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		Telegraf:           d.Get("telegraf").(string),
+		Username:           d.Get("username").(string),
+		MetaURL:            d.Get("meta_url").(string),
+		DefaultRP:          d.Get("default_rp").(string),
+	}
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		update.Password = &password
+	}
+	if d.HasChange("token") {
+		token := d.Get("token").(string)
+		update.Token = &token
+	}
+	if d.HasChange("shared_secret") {
+		sharedSecret := d.Get("shared_secret").(string)
+		update.SharedSecret = &sharedSecret
+	}
+
+	log.Printf("[INFO] Updating Source (%s)", id)
+	if _, err := api.updateSource(ctx, id, update); err != nil {
+		return diag.Errorf("unable to update Source (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Source (%s)", id)
+
+	return resourceSourceRead(ctx, d, meta)
+}
+
+func resourceSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Source (%s)", id)
+
+	if err := api.deleteSource(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Source (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Source (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Source (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setSourceResourceData(d *schema.ResourceData, s *source) error {
+	if err := d.Set("id", s.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", s.Type); err != nil {
+		return err
+	}
+	if err := d.Set("url", s.URL); err != nil {
+		return err
+	}
+	if err := d.Set("default", s.Default); err != nil {
+		return err
+	}
+	if err := d.Set("insecure_skip_verify", s.InsecureSkipVerify); err != nil {
+		return err
+	}
+	if err := d.Set("telegraf", s.Telegraf); err != nil {
+		return err
+	}
+	if err := d.Set("username", s.Username); err != nil {
+		return err
+	}
+	if err := d.Set("meta_url", s.MetaURL); err != nil {
+		return err
+	}
+	if err := d.Set("default_rp", s.DefaultRP); err != nil {
+		return err
+	}
+	if err := d.Set("languages", s.Languages); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxSource(name string, url string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_source" "v1" {
+			org_id   = data.influxdb2_organization.initial.id
+			name     = "%s"
+			url      = "%s"
+			username = "admin"
+			password = "password"
+		}
+`, testInitialOrg, name, url)
+}
+
+func TestAccResourceSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-source")
+	newName := acctest.RandomWithPrefix("test-source")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceSourceDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxSource(name, "http://influxdb1:8086")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_source.v1", "name", name),
+					resource.TestCheckResourceAttr("influxdb2_source.v1", "type", "v1"),
+					testAccResourceSourceExists(provider, "influxdb2_source.v1"),
+				),
+			},
+			importStep("influxdb2_source.v1", "password"),
+			{
+				//update
+				Config: testConfig(influxSource(newName, "http://influxdb1.example.com:8086")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_source.v1", "name", newName),
+					resource.TestCheckResourceAttr("influxdb2_source.v1", "url", "http://influxdb1.example.com:8086"),
+					testAccResourceSourceExists(provider, "influxdb2_source.v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSourceExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findSourceByID(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading Source %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceSourceDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_source" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findSourceByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Source %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceStack() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Stack resource allows you to configure a InfluxDB2 stack, which tracks the resources created by applying templates so they can be updated or removed together. Destroying a stack also deletes all the resources it tracks.",
+
+		CreateContext: withDefaultOrgID(resourceStackCreate),
+		ReadContext:   resourceStackRead,
+		UpdateContext: resourceStackUpdate,
+		DeleteContext: resourceStackDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStackImport,
+		},
+
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			// Required Inputs
+			"name": {
+				Description:      "Name of the stack.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the stack. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the stack.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"urls": {
+				Description: "URLs of the templates managed by the stack, e.g. community templates.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the stack.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resources": stackResourcesSchema(),
+		}, createdUpdatedSchema("stack")),
+	}
+}
+
+func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating Stack (%s)", name)
+	created, err := api.createStack(ctx, &stackCreate{
+		OrgID:       d.Get("org_id").(string),
+		Name:        name,
+		Description: d.Get("description").(string),
+		URLs:        expandStringList(d.Get("urls").([]interface{})),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create Stack (%s): %v", name, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create Stack (%s): <unknown error occurred>", name)
+	}
+
+	d.SetId(created.ID)
+
+	log.Printf("[INFO] Created Stack (%s) (%s)", name, created.ID)
+
+	return resourceStackRead(ctx, d, meta)
+}
+
+func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Stack (%s)", id)
+
+	s, err := api.findStackByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Stack (%s): %v", id, err)
+	}
+
+	if err := setStackResourceData(d, s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+	urls := expandStringList(d.Get("urls").([]interface{}))
+
+	log.Printf("[INFO] Updating Stack (%s)", id)
+	if _, err := api.updateStack(ctx, id, &stackUpdate{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		URLs:         urls,
+		TemplateURLs: urls,
+	}); err != nil {
+		return diag.Errorf("unable to update Stack (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Updated Stack (%s)", id)
+
+	return resourceStackRead(ctx, d, meta)
+}
+
+func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Stack (%s)", id)
+
+	if err := api.deleteStack(ctx, d.Get("org_id").(string), id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Stack (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Stack (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setStackResourceData(d *schema.ResourceData, s *stack) error {
+	if err := d.Set("id", s.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", s.Description); err != nil {
+		return err
+	}
+	if err := d.Set("urls", s.URLs); err != nil {
+		return err
+	}
+
+	if err := d.Set("resources", flattenStackResources(s.Resources)); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_at", s.CreatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", s.UpdatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("created_timestamp", s.CreatedAt.Unix()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_timestamp", s.UpdatedAt.Unix()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func stackResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The resources tracked by the stack, sorted by kind & name.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kind": {
+					Description: "The template kind of the resource, e.g. `Bucket`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"meta_name": {
+					Description: "The name of the resource in the template's metadata.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"resource_id": {
+					Description: "ID of the resource.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenStackResources(resources []stackResource) []interface{} {
+	res := make([]interface{}, 0, len(resources))
+	for _, r := range resources {
+		res = append(res, map[string]interface{}{
+			"kind":        r.Kind,
+			"meta_name":   r.MetaName,
+			"resource_id": r.ResourceID,
+		})
+	}
+	return res
+}
+
+// resourceStackImport imports a stack using an ID of the form `<org_id>/<stack_id>`, since
+// deleting a stack requires the Organization ID.
+func resourceStackImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <org_id>/<stack_id>", d.Id())
+	}
+
+	if err := d.Set("org_id", parts[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxStack(name string, description string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_stack" "stack" {
+			org_id      = data.influxdb2_organization.initial.id
+			name        = "%s"
+			description = "%s"
+		}
+`, testInitialOrg, name, description)
+}
+
+func TestAccResourceStack(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-stack")
+	newName := acctest.RandomWithPrefix("test-stack")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceStackDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxStack(name, "first")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_stack.stack", "name", name),
+					resource.TestCheckResourceAttr("influxdb2_stack.stack", "description", "first"),
+					testAccResourceStackExists(provider, "influxdb2_stack.stack"),
+				),
+			},
+			importStepWithOrgID("influxdb2_stack.stack"),
+			{
+				//update
+				Config: testConfig(influxStack(newName, "second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_stack.stack", "name", newName),
+					resource.TestCheckResourceAttr("influxdb2_stack.stack", "description", "second"),
+					testAccResourceStackExists(provider, "influxdb2_stack.stack"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceStackExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findStackByID(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading Stack %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceStackDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_stack" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findStackByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed Stack %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
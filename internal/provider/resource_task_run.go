@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/influxdata/influxdb-client-go/domain"
+)
+
+// taskRunPollInterval is how often a run is checked while waiting for its completion.
+var taskRunPollInterval = 2 * time.Second
+
+func resourceTaskRun() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Task Run resource starts a manual run of a task when it is created, e.g. a backfill after the task is created or changed. Any change runs the task again, use `triggers` to re-run it. Destroying the resource only removes it from state.",
+
+		CreateContext: resourceTaskRunCreate,
+		ReadContext:   resourceTaskRunRead,
+		DeleteContext: resourceTaskRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"task_id": {
+				Description: "ID of the task to run.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			// Optional Inputs
+			"scheduled_for": {
+				Description:      "The time, as an RFC3339 timestamp, that the run is for, i.e. the `now()` of its query. Defaults to now.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRFC3339,
+			},
+			"wait_for_completion": {
+				Description: "Whether to wait for the run to finish, failing the apply when the run fails. The wait is bound by the `create` timeout. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values that run the task again when changed.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"run_id": {
+				Description: "ID of the run.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the run: `scheduled`, `started`, `success`, `failed` or `canceled`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"started_at": {
+				Description: "When the run started.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"finished_at": {
+				Description: "When the run finished.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceTaskRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	taskID := d.Get("task_id").(string)
+
+	manual := &domain.RunManually{}
+	if v, ok := d.GetOk("scheduled_for"); ok {
+		scheduledFor, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("invalid scheduled_for: %v", err)
+		}
+		manual.ScheduledFor = &scheduledFor
+	}
+
+	log.Printf("[INFO] Running Task (%s)", taskID)
+	run, err := api.runTask(ctx, taskID, manual)
+	if err != nil {
+		return diag.Errorf("unable to run Task (%s): %v", taskID, err)
+	}
+	if run.Id == nil {
+		return diag.Errorf("unable to run Task (%s): <unknown error occurred>", taskID)
+	}
+	runID := *run.Id
+
+	d.SetId(fmt.Sprintf("%s/%s", taskID, runID))
+	if err := d.Set("run_id", runID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Started run (%s) of Task (%s)", runID, taskID)
+
+	if d.Get("wait_for_completion").(bool) {
+		for !isTaskRunDone(run) {
+			timer := time.NewTimer(taskRunPollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return diag.Errorf("timed out waiting for run (%s) of Task (%s) to finish", runID, taskID)
+			case <-timer.C:
+			}
+
+			if run, err = api.findTaskRun(ctx, taskID, runID); err != nil {
+				return diag.Errorf("unable to retrieve run (%s) of Task (%s): %v", runID, taskID, err)
+			}
+		}
+		if err := setTaskRunResourceData(d, run); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if *run.Status != domain.RunStatusSuccess {
+			return diag.Errorf("run (%s) of Task (%s) %s%s", runID, taskID, *run.Status, taskRunLogsSuffix(ctx, api, taskID, runID))
+		}
+
+		log.Printf("[INFO] Run (%s) of Task (%s) finished", runID, taskID)
+		return nil
+	}
+
+	return resourceTaskRunRead(ctx, d, meta)
+}
+
+// taskRunLogsSuffix returns the log messages of a run, to explain why it failed.
+func taskRunLogsSuffix(ctx context.Context, api *apiClient, taskID string, runID string) string {
+	events, err := api.findTaskRunLogs(ctx, taskID, runID)
+	if err != nil {
+		log.Printf("[WARN] unable to retrieve the logs of run (%s) of Task (%s): %v", runID, taskID, err)
+		return ""
+	}
+	var messages []string
+	for _, e := range events {
+		if m := stringValue(e.Message); m != "" {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(messages, "; ")
+}
+
+func resourceTaskRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	taskID := d.Get("task_id").(string)
+	runID := d.Get("run_id").(string)
+
+	log.Printf("[INFO] Reading run (%s) of Task (%s)", runID, taskID)
+
+	run, err := api.findTaskRun(ctx, taskID, runID)
+	if err != nil {
+		// runs are purged after a while, which doesn't undo them
+		if isNotFound(err) {
+			log.Printf("[WARN] Run (%s) of Task (%s) not found, keeping it in state", runID, taskID)
+			return nil
+		}
+		return diag.Errorf("unable to retrieve run (%s) of Task (%s): %v", runID, taskID, err)
+	}
+
+	if err := setTaskRunResourceData(d, run); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTaskRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Task runs can't be undone, removing Task Run (%s) from state only", d.Id())
+
+	return nil
+}
+
+func setTaskRunResourceData(d *schema.ResourceData, run *domain.Run) error {
+	if run.Status != nil {
+		if err := d.Set("status", string(*run.Status)); err != nil {
+			return err
+		}
+	}
+	if run.StartedAt != nil {
+		if err := d.Set("started_at", run.StartedAt.UTC().Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	if run.FinishedAt != nil {
+		if err := d.Set("finished_at", run.FinishedAt.UTC().Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testTaskRunServer serves a run of task 0000000000000001 that goes through the statuses, one
+// per request, after being scheduled.
+func testTaskRunServer(t *testing.T, statuses ...string) *httptest.Server {
+	var mu sync.Mutex
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/tasks/0000000000000001/runs", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["scheduledFor"] != "2021-06-01T00:00:00Z" {
+			t.Errorf("expected the run to be scheduled for 2021-06-01T00:00:00Z, got %v (%v)", body, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"0000000000000002","taskID":"0000000000000001","status":"scheduled"}`))
+	})
+	mux.HandleFunc("/api/v2/tasks/0000000000000001/runs/0000000000000002", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"0000000000000002","taskID":"0000000000000001","status":"` + status + `","startedAt":"2021-06-01T00:00:01Z","finishedAt":"2021-06-01T00:00:02Z"}`))
+	})
+	mux.HandleFunc("/api/v2/tasks/0000000000000001/runs/0000000000000002/logs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"events":[{"message":"Started task from script"},{"message":"error exhausting result iterator: bucket not found"}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestResourceTaskRunCreate(t *testing.T) {
+	interval := taskRunPollInterval
+	taskRunPollInterval = time.Millisecond
+	t.Cleanup(func() { taskRunPollInterval = interval })
+
+	for _, tc := range []struct {
+		name     string
+		statuses []string
+		err      string
+	}{
+		{name: "success", statuses: []string{"started", "success"}},
+		{name: "failed", statuses: []string{"started", "failed"}, err: "failed: Started task from script; error exhausting result iterator: bucket not found"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := testTaskRunServer(t, tc.statuses...)
+
+			d := schema.TestResourceDataRaw(t, resourceTaskRun().Schema, map[string]interface{}{
+				"task_id":             "0000000000000001",
+				"scheduled_for":       "2021-06-01T00:00:00Z",
+				"wait_for_completion": true,
+			})
+			diags := resourceTaskRunCreate(context.Background(), d, &metaData{api: newAPIClient(srv.URL, "token")})
+
+			if tc.err == "" && diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if tc.err != "" && (len(diags) != 1 || !strings.Contains(diags[0].Summary, tc.err)) {
+				t.Fatalf("expected an error with %q, got %v", tc.err, diags)
+			}
+			if got := d.Get("run_id").(string); got != "0000000000000002" {
+				t.Errorf("expected the run ID, got %q", got)
+			}
+			if got := d.Get("status").(string); got != tc.statuses[len(tc.statuses)-1] {
+				t.Errorf("expected the status of the finished run, got %q", got)
+			}
+			if got := d.Get("finished_at").(string); got != "2021-06-01T00:00:02Z" {
+				t.Errorf("expected finished_at, got %q", got)
+			}
+		})
+	}
+}
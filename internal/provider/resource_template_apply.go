@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTemplateApply() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Template Apply resource applies an InfluxDB2 template to an Organization. The resources created by the template are tracked by a stack: changing the template re-applies it to the same stack, which also removes the resources no longer in the template, and destroying the resource deletes the stack and all its resources. Use `template` with `file()` to apply a local template file.",
+
+		CreateContext: withDefaultOrgID(resourceTemplateApplyCreate),
+		ReadContext:   resourceTemplateApplyRead,
+		UpdateContext: resourceTemplateApplyUpdate,
+		DeleteContext: resourceTemplateApplyDelete,
+		CustomizeDiff: resourceTemplateApplyCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStackImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization to apply the template to. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				// set to the Organization of the provider at create when unset
+				DiffSuppressFunc: suppressDefaultOrgID,
+			},
+			"template": {
+				Description:      "The template contents, as YAML or JSON. Exactly one of `template` and `template_url` must be set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"template", "template_url"},
+				ValidateDiagFunc: validateTemplate,
+			},
+			"template_url": {
+				Description:  "URL of the template, fetched by InfluxDB2 when the template is applied. Changes to the template behind the URL are not detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"template", "template_url"},
+			},
+			"env_refs": {
+				Description: "Values of the environment references used by the template.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// Computed outputs
+			"stack_id": {
+				Description: "ID of the stack tracking the resources created by the template.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resources": stackResourcesSchema(),
+		},
+	}
+}
+
+func validateTemplate(v interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := parseTemplate(v.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid template",
+			Detail:        fmt.Sprintf("unable to parse the template: %v", err),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// resourceTemplateApplyCustomizeDiff checks the quotas for the buckets, tasks & dashboards
+// that applying an inline template would add to the stack, see checkQuota.
+func resourceTemplateApplyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("template") || !d.NewValueKnown("template") {
+		return nil
+	}
+	orgID, ok := diffOrgID(d, meta)
+	if !ok {
+		return nil
+	}
+	template := d.Get("template").(string)
+	if template == "" {
+		return nil
+	}
+
+	contents, err := parseTemplate(template)
+	if err != nil {
+		return err
+	}
+	var objects []struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(contents, &objects); err != nil {
+		return err
+	}
+
+	adding := map[string]int{}
+	for _, o := range objects {
+		if kind, ok := quotaTemplateKinds[o.Kind]; ok {
+			adding[kind]++
+		}
+	}
+	// the resources already in the stack are updated in place
+	for _, raw := range d.Get("resources").([]interface{}) {
+		if r, ok := raw.(map[string]interface{}); ok {
+			if kind, ok := quotaTemplateKinds[r["kind"].(string)]; ok {
+				adding[kind]--
+			}
+		}
+	}
+
+	for _, kind := range []string{quotaBucket, quotaTask, quotaDashboard} {
+		if err := checkQuota(ctx, meta, orgID, kind, adding[kind]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func templateApplyFromResourceData(d *schema.ResourceData) (*templateApply, error) {
+	apply := &templateApply{
+		OrgID:   d.Get("org_id").(string),
+		StackID: d.Id(),
+		EnvRefs: map[string]string{},
+	}
+
+	if v, ok := d.GetOk("template"); ok {
+		contents, err := parseTemplate(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		apply.Template = &templateContents{
+			ContentType: "json",
+			Contents:    json.RawMessage(contents),
+		}
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		apply.Remotes = []templateRemote{{URL: v.(string)}}
+	}
+	for k, v := range d.Get("env_refs").(map[string]interface{}) {
+		apply.EnvRefs[k] = v.(string)
+	}
+
+	return apply, nil
+}
+
+func resourceTemplateApplyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	orgID := d.Get("org_id").(string)
+
+	apply, err := templateApplyFromResourceData(d)
+	if err != nil {
+		return diag.Errorf("unable to parse the template: %v", err)
+	}
+
+	log.Printf("[INFO] Applying template to Organization (%s)", orgID)
+	stackID, err := api.applyTemplate(ctx, apply)
+	if err != nil {
+		return diag.Errorf("unable to apply template to Organization (%s): %v", orgID, err)
+	}
+	if stackID == "" {
+		return diag.Errorf("unable to apply template to Organization (%s): <unknown error occurred>", orgID)
+	}
+
+	d.SetId(stackID)
+
+	log.Printf("[INFO] Applied template to Organization (%s), tracked by Stack (%s)", orgID, stackID)
+
+	return resourceTemplateApplyRead(ctx, d, meta)
+}
+
+func resourceTemplateApplyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading Stack (%s) of applied template", id)
+
+	s, err := api.findStackByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, removing applied template from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve Stack (%s): %v", id, err)
+	}
+
+	if err := d.Set("org_id", s.OrgID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("stack_id", s.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resources", flattenStackResources(s.Resources)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTemplateApplyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	apply, err := templateApplyFromResourceData(d)
+	if err != nil {
+		return diag.Errorf("unable to parse the template: %v", err)
+	}
+
+	log.Printf("[INFO] Re-applying template to Stack (%s)", id)
+	if _, err := api.applyTemplate(ctx, apply); err != nil {
+		return diag.Errorf("unable to re-apply template to Stack (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Re-applied template to Stack (%s)", id)
+
+	return resourceTemplateApplyRead(ctx, d, meta)
+}
+
+func resourceTemplateApplyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting Stack (%s) of applied template", id)
+
+	if err := api.deleteStack(ctx, d.Get("org_id").(string), id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete Stack (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] Stack (%s) deleted, removing applied template from state", id)
+
+	return nil
+}
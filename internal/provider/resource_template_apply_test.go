@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseTemplate(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"yaml documents", `
+apiVersion: influxdata.com/v2alpha1
+kind: Bucket
+metadata:
+  name: a
+spec:
+  retentionRules:
+    - everySeconds: 3600
+---
+apiVersion: influxdata.com/v2alpha1
+kind: Label
+metadata:
+  name: b
+`, `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"a"},"spec":{"retentionRules":[{"everySeconds":3600}]}},{"apiVersion":"influxdata.com/v2alpha1","kind":"Label","metadata":{"name":"b"}}]`},
+		{"json list", `[{"kind":"Bucket","metadata":{"name":"a"}}]`, `[{"kind":"Bucket","metadata":{"name":"a"}}]`},
+		{"json object", `{"kind":"Bucket","metadata":{"name":"a"}}`, `[{"kind":"Bucket","metadata":{"name":"a"}}]`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseTemplate(c.template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, got)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "just a string", "kind: [unclosed"} {
+		if _, err := parseTemplate(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestTemplateYAML(t *testing.T) {
+	objects := `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"a"},"spec":{"retentionRules":[{"everySeconds":2592000}]}},{"apiVersion":"influxdata.com/v2alpha1","kind":"Label","metadata":{"name":"b"},"spec":{"color":"#326BBA"}}]`
+
+	got, err := templateYAML([]byte(objects))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `apiVersion: influxdata.com/v2alpha1
+kind: Bucket
+metadata:
+  name: a
+spec:
+  retentionRules:
+  - everySeconds: 2592000
+---
+apiVersion: influxdata.com/v2alpha1
+kind: Label
+metadata:
+  name: b
+spec:
+  color: '#326BBA'
+`
+	if got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+
+	// the YAML can be applied as is
+	parsed, err := parseTemplate(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(parsed) != objects {
+		t.Errorf("expected %s, got %s", objects, parsed)
+	}
+}
+
+func influxTemplateApply(bucket string, label string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_template_apply" "template" {
+			org_id   = data.influxdb2_organization.initial.id
+			template = <<-EOT
+				apiVersion: influxdata.com/v2alpha1
+				kind: Bucket
+				metadata:
+				  name: %s
+				---
+				apiVersion: influxdata.com/v2alpha1
+				kind: Label
+				metadata:
+				  name: %s
+			EOT
+		}
+`, testInitialOrg, bucket, label)
+}
+
+func TestAccResourceTemplateApply(t *testing.T) {
+	bucket := acctest.RandomWithPrefix("test-bucket")
+	label := acctest.RandomWithPrefix("test-label")
+	newLabel := acctest.RandomWithPrefix("test-label")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceTemplateApplyDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxTemplateApply(bucket, label)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.#", "2"),
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.0.kind", "Bucket"),
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.1.kind", "Label"),
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.1.meta_name", label),
+					testAccResourceStackExists(provider, "influxdb2_template_apply.template"),
+				),
+			},
+			{
+				//update
+				Config: testConfig(influxTemplateApply(bucket, newLabel)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.#", "2"),
+					resource.TestCheckResourceAttr("influxdb2_template_apply.template", "resources.1.meta_name", newLabel),
+					testAccResourceStackExists(provider, "influxdb2_template_apply.template"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTemplateApplyDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_template_apply" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findStackByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find the Stack %q of a destroyed template: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
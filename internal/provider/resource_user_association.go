@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userAssociation describes a resource that grants a user a role (member or owner) on an
+// object, using the object's `/members` or `/owners` sub-API.
+type userAssociation struct {
+	// itemType is the user facing name of the object, e.g. "Organization"
+	itemType string
+	// idAttribute is the attribute holding the object's ID, e.g. "org_id"
+	idAttribute string
+	// collectionPath is the API path of the objects, e.g. "/api/v2/orgs"
+	collectionPath string
+	// role is the name of the sub-API, "members" or "owners"
+	role string
+}
+
+func (a userAssociation) roleName() string {
+	return strings.TrimSuffix(a.role, "s")
+}
+
+func (a userAssociation) path(d *schema.ResourceData) string {
+	return a.collectionPath + "/" + d.Get(a.idAttribute).(string)
+}
+
+func resourceUserAssociation(a userAssociation, description string) *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: description,
+
+		CreateContext: a.create,
+		ReadContext:   a.read,
+		DeleteContext: a.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: a.importState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			a.idAttribute: {
+				Description: fmt.Sprintf("ID of the %s.", a.itemType),
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: fmt.Sprintf("ID of the user to make a %s of the %s.", a.roleName(), a.itemType),
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			// Computed outputs
+			"user_name": {
+				Description: "Name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (a userAssociation) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	objectID := d.Get(a.idAttribute).(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] Adding user (%s) as %s of %s (%s)", userID, a.roleName(), a.itemType, objectID)
+	if err := api.addResourceUser(ctx, a.path(d), a.role, userID); err != nil {
+		return diag.Errorf("unable to add user (%s) as %s of %s (%s): %v", userID, a.roleName(), a.itemType, objectID, err)
+	}
+
+	d.SetId(objectID + "/" + userID)
+
+	log.Printf("[INFO] Added user (%s) as %s of %s (%s)", userID, a.roleName(), a.itemType, objectID)
+
+	return a.read(ctx, d, meta)
+}
+
+func (a userAssociation) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	objectID := d.Get(a.idAttribute).(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] Reading %s (%s) of %s (%s)", a.roleName(), userID, a.itemType, objectID)
+
+	user, err := api.findResourceUser(ctx, a.path(d), a.role, userID)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] user (%s) is no longer a %s of %s (%s), removing from state", userID, a.roleName(), a.itemType, objectID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve %s of %s (%s): %v", a.role, a.itemType, objectID, err)
+	}
+
+	if err := d.Set("user_name", user.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func (a userAssociation) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	objectID := d.Get(a.idAttribute).(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] Removing user (%s) as %s of %s (%s)", userID, a.roleName(), a.itemType, objectID)
+
+	if err := api.removeResourceUser(ctx, a.path(d), a.role, userID); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] user (%s) or %s (%s) not found, so no action was taken", userID, a.itemType, objectID)
+			return nil
+		}
+		return diag.Errorf("unable to remove user (%s) as %s of %s (%s): %v", userID, a.roleName(), a.itemType, objectID, err)
+	}
+
+	log.Printf("[INFO] Removed user (%s) as %s of %s (%s), removing from state", userID, a.roleName(), a.itemType, objectID)
+
+	return nil
+}
+
+// importState imports an association using an ID of the form `<object_id>/<user_id>`.
+func (a userAssociation) importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <%s>/<user_id>", d.Id(), a.idAttribute)
+	}
+
+	if err := d.Set(a.idAttribute, parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("user_id", parts[1]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
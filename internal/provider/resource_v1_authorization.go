@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceV1Authorization() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The V1 Authorization resource allows you to configure a legacy authorization, i.e. a username & password that InfluxDB 1.x clients, e.g. Grafana InfluxQL data sources, use with the 1.x compatibility API. Buckets need a DBRP mapping to be queried with InfluxQL, see `influxdb2_dbrp`. The API never returns the password, so changes made to it outside of Terraform can't be detected.",
+
+		CreateContext: withDefaultOrgID(resourceV1AuthorizationCreate),
+		ReadContext:   resourceV1AuthorizationRead,
+		UpdateContext: resourceV1AuthorizationUpdate,
+		DeleteContext: resourceV1AuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			// Required Inputs
+			"username": {
+				Description:      "The username used by 1.x clients, unique in the instance.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"permissions": permissionsInputSchema("The permissions granted by the authorization, usually `read` and `write` on `buckets`."),
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the authorization. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Description: "The password used by 1.x clients. It is write-only: the value in state is always the last value written by Terraform. Without a password, 1.x clients can only authenticate with the username as a token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"user_id": {
+				Description: "ID of the user the authorization belongs to. Defaults to the user of the provider's token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the authorization.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description:      "The status of the authorization, `active` or `inactive`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "active",
+				ValidateDiagFunc: validateStringInSlice([]string{"active", "inactive"}, false),
+			},
+			// Computed outputs
+			"id": {
+				Description: "ID of the authorization.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, createdUpdatedSchema("authorization")),
+	}
+}
+
+func resourceV1AuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	username := d.Get("username").(string)
+
+	log.Printf("[INFO] Creating V1 Authorization (%s)", username)
+	created, err := api.createV1Authorization(ctx, &v1AuthorizationCreate{
+		OrgID:       d.Get("org_id").(string),
+		UserID:      d.Get("user_id").(string),
+		Username:    username,
+		Description: d.Get("description").(string),
+		Status:      d.Get("status").(string),
+		Permissions: expandPermissions(d.Get("permissions").(*schema.Set)),
+	})
+	if err != nil {
+		return diag.Errorf("unable to create V1 Authorization (%s): %v", username, err)
+	}
+	if created.ID == "" {
+		return diag.Errorf("unable to create V1 Authorization (%s): <unknown error occurred>", username)
+	}
+
+	d.SetId(created.ID)
+
+	rb := newRollback(d)
+	rb.add(fmt.Sprintf("V1 Authorization (%s)", created.ID), func(ctx context.Context) error {
+		return api.deleteV1Authorization(ctx, created.ID)
+	})
+	if password := d.Get("password").(string); password != "" {
+		if err := api.setV1AuthorizationPassword(ctx, created.ID, password); err != nil {
+			return rb.run(ctx, diag.Errorf("unable to set the password of V1 Authorization (%s): %v", created.ID, err))
+		}
+	}
+
+	log.Printf("[INFO] Created V1 Authorization (%s) (%s)", username, created.ID)
+
+	return resourceV1AuthorizationRead(ctx, d, meta)
+}
+
+func resourceV1AuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Reading V1 Authorization (%s)", id)
+
+	a, err := api.findV1AuthorizationByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] V1 Authorization (%s) not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("unable to retrieve V1 Authorization (%s): %v", id, err)
+	}
+
+	if err := setV1AuthorizationResourceData(d, a); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceV1AuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Updating V1 Authorization (%s)", id)
+	if d.HasChanges("description", "status") {
+		if _, err := api.updateV1Authorization(ctx, id, &v1AuthorizationUpdate{
+			Description: d.Get("description").(string),
+			Status:      d.Get("status").(string),
+		}); err != nil {
+			return diag.Errorf("unable to update V1 Authorization (%s): %v", id, err)
+		}
+	}
+	if d.HasChange("password") {
+		if err := api.setV1AuthorizationPassword(ctx, id, d.Get("password").(string)); err != nil {
+			return diag.Errorf("unable to set the password of V1 Authorization (%s): %v", id, err)
+		}
+	}
+
+	log.Printf("[INFO] Updated V1 Authorization (%s)", id)
+
+	return resourceV1AuthorizationRead(ctx, d, meta)
+}
+
+func resourceV1AuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	id := d.Id()
+
+	log.Printf("[INFO] Deleting V1 Authorization (%s)", id)
+
+	if err := api.deleteV1Authorization(ctx, id); err != nil {
+		if isNotFound(err) {
+			log.Printf("[WARN] V1 Authorization (%s) not found, so no action was taken", id)
+			return nil
+		}
+		return diag.Errorf("unable to delete V1 Authorization (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] V1 Authorization (%s) deleted, removing from state", id)
+
+	return nil
+}
+
+func setV1AuthorizationResourceData(d *schema.ResourceData, a *v1Authorization) error {
+	if err := d.Set("id", a.ID); err != nil {
+		return err
+	}
+	if err := d.Set("org_id", a.OrgID); err != nil {
+		return err
+	}
+	if err := d.Set("user_id", a.UserID); err != nil {
+		return err
+	}
+	if err := d.Set("username", a.Username); err != nil {
+		return err
+	}
+	if err := d.Set("description", a.Description); err != nil {
+		return err
+	}
+	if err := d.Set("status", a.Status); err != nil {
+		return err
+	}
+	if err := d.Set("permissions", flattenPermissions(a.Permissions)); err != nil {
+		return err
+	}
+	if err := d.Set("created_at", a.CreatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", a.UpdatedAt.UTC().String()); err != nil {
+		return err
+	}
+	if err := d.Set("created_timestamp", a.CreatedAt.Unix()); err != nil {
+		return err
+	}
+	if err := d.Set("updated_timestamp", a.UpdatedAt.Unix()); err != nil {
+		return err
+	}
+	return nil
+}
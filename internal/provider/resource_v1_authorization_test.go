@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func influxV1Authorization(username string, bucketID string, description string, password string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_v1_authorization" "grafana" {
+			org_id      = data.influxdb2_organization.initial.id
+			username    = "%s"
+			description = "%s"
+			password    = "%s"
+
+			permissions {
+				action = "read"
+				type   = "buckets"
+				id     = "%s"
+				org_id = data.influxdb2_organization.initial.id
+			}
+		}
+`, testInitialOrg, username, description, password, bucketID)
+}
+
+func TestAccResourceV1Authorization(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+	username := acctest.RandomWithPrefix("test-v1-auth")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		CheckDestroy:      testAccCheckResourceV1AuthorizationDestroy(t, provider),
+		Steps: []resource.TestStep{
+			{
+				//create
+				Config: testConfig(influxV1Authorization(username, bucketID, "first", "password-1")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_v1_authorization.grafana", "username", username),
+					resource.TestCheckResourceAttr("influxdb2_v1_authorization.grafana", "status", "active"),
+					resource.TestCheckResourceAttr("influxdb2_v1_authorization.grafana", "permissions.#", "1"),
+					resource.TestCheckResourceAttrSet("influxdb2_v1_authorization.grafana", "user_id"),
+					testAccResourceV1AuthorizationExists(provider, "influxdb2_v1_authorization.grafana"),
+				),
+			},
+			importStep("influxdb2_v1_authorization.grafana", "password"),
+			{
+				//update
+				Config: testConfig(influxV1Authorization(username, bucketID, "second", "password-2")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb2_v1_authorization.grafana", "description", "second"),
+					testAccResourceV1AuthorizationExists(provider, "influxdb2_v1_authorization.grafana"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceV1AuthorizationExists(testProvider *schema.Provider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+		if id == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		api := testProvider.Meta().(*metaData).api
+
+		if _, err := api.findV1AuthorizationByID(context.Background(), id); err != nil {
+			return fmt.Errorf("Got an error when reading V1 Authorization %q: %v", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceV1AuthorizationDestroy(t *testing.T, testProvider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testProvider.Meta() == nil {
+			t.Fatal("got nil provider metadata")
+		}
+		api := testProvider.Meta().(*metaData).api
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "influxdb2_v1_authorization" {
+				continue
+			}
+			id := rs.Primary.ID
+
+			_, err := api.findV1AuthorizationByID(context.Background(), id)
+			if !isNotFound(err) {
+				return fmt.Errorf("Was able to find destroyed V1 Authorization %q: %v", id, err)
+			}
+		}
+		return nil
+	}
+}
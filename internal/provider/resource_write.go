@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWrite() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "The Write resource writes line protocol into a bucket when it is created, e.g. to seed reference data or to smoke test a newly created bucket. Any change re-writes the data, including a change to the contents of `source_file`. The data is never read back: destroying the resource only removes it from state, and points written again with the same series & timestamp overwrite the previous values.",
+
+		CreateContext: withDefaultOrgID(resourceWriteCreate),
+		ReadContext:   resourceWriteRead,
+		DeleteContext: resourceWriteDelete,
+		CustomizeDiff: resourceWriteCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Required Inputs
+			"bucket_id": {
+				Description: "ID of the bucket to write to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			// Optional Inputs
+			"org_id": {
+				Description: "ID of the Organization that owns the bucket. Defaults to the `org_id` of the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"line_protocol": {
+				Description:  "The line protocol to write. Exactly one of `line_protocol`, `source_file` and `point` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"line_protocol", "source_file", "point"},
+			},
+			"source_file": {
+				Description:  "Path of a local file containing the line protocol to write.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"line_protocol", "source_file", "point"},
+			},
+			"point": {
+				Description:  "Points to write, escaped as needed for line protocol.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"line_protocol", "source_file", "point"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"measurement": {
+							Description: "The measurement of the point.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"tags": {
+							Description: "The tags of the point.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"fields": {
+							Description: "The numeric & boolean fields of the point, as line protocol values, e.g. `1.5`, `42i` or `true`.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"string_fields": {
+							Description: "The string fields of the point.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"timestamp": {
+							Description: "The timestamp of the point, in `precision`. When omitted, the server time is used.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"precision": {
+				Description:      "The precision of the timestamps: `ns`, `us`, `ms` or `s`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "ns",
+				ValidateDiagFunc: validateStringInSlice([]string{"ns", "us", "ms", "s"}, false),
+			},
+			// Computed outputs
+			"content_sha256": {
+				Description: "SHA-256 hash of the written line protocol.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceGetter is implemented by both *schema.ResourceData & *schema.ResourceDiff.
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// writeLineProtocol returns the line protocol to write, from whichever of `line_protocol`,
+// `source_file` and `point` is set.
+func writeLineProtocol(d resourceGetter) ([]byte, error) {
+	var (
+		lp  []byte
+		err error
+	)
+	if v := d.Get("line_protocol").(string); v != "" {
+		lp = []byte(v)
+	} else if path := d.Get("source_file").(string); path != "" {
+		if lp, err = ioutil.ReadFile(path); err != nil {
+			return nil, err
+		}
+	} else {
+		var lines []string
+		for i, raw := range d.Get("point").([]interface{}) {
+			m, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			line, err := encodePoint(m)
+			if err != nil {
+				return nil, fmt.Errorf("point %d: %v", i, err)
+			}
+			lines = append(lines, line)
+		}
+		lp = []byte(strings.Join(lines, "\n"))
+	}
+
+	if len(strings.TrimSpace(string(lp))) == 0 {
+		return nil, fmt.Errorf("there is no line protocol to write")
+	}
+	return lp, nil
+}
+
+var (
+	lineProtocolFieldValuePattern = regexp.MustCompile(`^(-?\d+(\.\d+)?([eE][+-]?\d+)?|-?\d+i|\d+u|t|T|true|True|TRUE|f|F|false|False|FALSE)$`)
+	lineProtocolTimestampPattern  = regexp.MustCompile(`^-?\d+$`)
+)
+
+// encodePoint encodes a `point` block as a line of line protocol, with tags & fields sorted
+// by key.
+func encodePoint(m map[string]interface{}) (string, error) {
+	measurement, err := lineProtocolEscape(lineProtocolMeasurement, m["measurement"].(string))
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(measurement)
+
+	tags := m["tags"].(map[string]interface{})
+	for _, k := range sortedKeys(tags) {
+		key, err := lineProtocolEscape(lineProtocolTagKey, k)
+		if err != nil {
+			return "", err
+		}
+		value, err := lineProtocolEscape(lineProtocolTagValue, tags[k].(string))
+		if err != nil {
+			return "", fmt.Errorf("tag %q: %v", k, err)
+		}
+		sb.WriteString("," + key + "=" + value)
+	}
+
+	fields := map[string]string{}
+	raw := m["fields"].(map[string]interface{})
+	for k, v := range raw {
+		if !lineProtocolFieldValuePattern.MatchString(v.(string)) {
+			return "", fmt.Errorf("field %q: %q isn't a numeric or boolean line protocol value, use string_fields for strings", k, v)
+		}
+		fields[k] = v.(string)
+	}
+	for k, v := range m["string_fields"].(map[string]interface{}) {
+		if _, ok := fields[k]; ok {
+			return "", fmt.Errorf("field %q is set in both fields & string_fields", k)
+		}
+		value, err := lineProtocolEscape(lineProtocolFieldString, v.(string))
+		if err != nil {
+			return "", err
+		}
+		fields[k] = value
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("a point needs at least one field")
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		key, err := lineProtocolEscape(lineProtocolFieldKey, k)
+		if err != nil {
+			return "", err
+		}
+		if i == 0 {
+			sb.WriteString(" ")
+		} else {
+			sb.WriteString(",")
+		}
+		sb.WriteString(key + "=" + fields[k])
+	}
+
+	if ts := m["timestamp"].(string); ts != "" {
+		if !lineProtocolTimestampPattern.MatchString(ts) {
+			return "", fmt.Errorf("timestamp %q isn't an integer", ts)
+		}
+		sb.WriteString(" " + ts)
+	}
+
+	return sb.String(), nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contentSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// resourceWriteCustomizeDiff validates the line protocol to write at plan time, and
+// re-writes the data when the contents of `source_file` changed.
+func resourceWriteCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"line_protocol", "source_file", "point"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	if !pointsKnown(d) {
+		return nil
+	}
+
+	lp, err := writeLineProtocol(d)
+	if err != nil {
+		return err
+	}
+
+	sum := contentSHA256(lp)
+	if d.Get("content_sha256").(string) == sum {
+		return nil
+	}
+	if err := d.SetNew("content_sha256", sum); err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		return d.ForceNew("content_sha256")
+	}
+	return nil
+}
+
+// pointsKnown reports whether every value of the point blocks is known. A point with an
+// unknown value, e.g. a field set from another resource, leaves point itself known, but
+// reads as if the value were missing. A map with an unknown value has an unknown size.
+func pointsKnown(d *schema.ResourceDiff) bool {
+	for i := range d.Get("point").([]interface{}) {
+		prefix := fmt.Sprintf("point.%d.", i)
+		for _, k := range []string{"measurement", "timestamp", "tags.%", "fields.%", "string_fields.%"} {
+			if !d.NewValueKnown(prefix + k) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func resourceWriteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*metaData).api
+
+	bucketID := d.Get("bucket_id").(string)
+
+	lp, err := writeLineProtocol(d)
+	if err != nil {
+		return diag.Errorf("unable to read the line protocol to write: %v", err)
+	}
+	sum := contentSHA256(lp)
+
+	log.Printf("[INFO] Writing %d bytes of line protocol to bucket (%s)", len(lp), bucketID)
+	if err := api.write(ctx, d.Get("org_id").(string), bucketID, d.Get("precision").(string), lp); err != nil {
+		return diag.Errorf("unable to write to bucket (%s): %v", bucketID, err)
+	}
+
+	d.SetId(bucketID + "/" + sum)
+	if err := d.Set("content_sha256", sum); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Wrote line protocol to bucket (%s)", bucketID)
+
+	return nil
+}
+
+// resourceWriteRead does nothing, since written data can't be read back as it was written.
+func resourceWriteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceWriteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Written data isn't deleted, removing Write (%s) from state only", d.Id())
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEncodePoint(t *testing.T) {
+	cases := []struct {
+		name  string
+		point map[string]interface{}
+		line  string
+		err   string
+	}{
+		{
+			name: "escaped",
+			point: map[string]interface{}{
+				"measurement":   "host info",
+				"tags":          map[string]interface{}{"region": "eu, west", "host": "a=b"},
+				"fields":        map[string]interface{}{"cpus": "8i", "up": "true"},
+				"string_fields": map[string]interface{}{"owner": `team "infra"`},
+				"timestamp":     "1600000000",
+			},
+			line: `host\ info,host=a\=b,region=eu\,\ west cpus=8i,owner="team \"infra\"",up=true 1600000000`,
+		},
+		{
+			name: "string in fields",
+			point: map[string]interface{}{
+				"measurement":   "m",
+				"tags":          map[string]interface{}{},
+				"fields":        map[string]interface{}{"owner": "infra"},
+				"string_fields": map[string]interface{}{},
+				"timestamp":     "",
+			},
+			err: `use string_fields for strings`,
+		},
+		{
+			name: "no fields",
+			point: map[string]interface{}{
+				"measurement":   "m",
+				"tags":          map[string]interface{}{"host": "a"},
+				"fields":        map[string]interface{}{},
+				"string_fields": map[string]interface{}{},
+				"timestamp":     "",
+			},
+			err: "at least one field",
+		},
+		{
+			name: "newline in tag",
+			point: map[string]interface{}{
+				"measurement":   "m",
+				"tags":          map[string]interface{}{"host": "a\nb"},
+				"fields":        map[string]interface{}{"v": "1"},
+				"string_fields": map[string]interface{}{},
+				"timestamp":     "",
+			},
+			err: "can't contain a newline",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			line, err := encodePoint(c.point)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected an error containing %q, got: %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if line != c.line {
+				t.Fatalf("expected %q, got %q", c.line, line)
+			}
+		})
+	}
+}
+
+func TestResourceWriteCustomizeDiffUnknownPoint(t *testing.T) {
+	for name, point := range map[string]map[string]interface{}{
+		"field":       {"measurement": "cpu", "fields": map[string]interface{}{"value": unknownValue}},
+		"tag":         {"measurement": "cpu", "tags": map[string]interface{}{"host": unknownValue}, "fields": map[string]interface{}{"value": "1"}},
+		"measurement": {"measurement": unknownValue, "fields": map[string]interface{}{"value": "1"}},
+		"timestamp":   {"measurement": "cpu", "fields": map[string]interface{}{"value": "1"}, "timestamp": unknownValue},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"org_id":    "0000000000000001",
+				"bucket_id": "0000000000000002",
+				"point":     []interface{}{point},
+			})
+			diff, err := resourceWrite().Diff(context.Background(), nil, config, &metaData{})
+			if err != nil {
+				t.Fatalf("expected the validation to wait for the unknown value, got: %v", err)
+			}
+			if a := diff.Attributes["content_sha256"]; a == nil || !a.NewComputed {
+				t.Errorf("expected the hash to be unknown until apply, got %+v", a)
+			}
+		})
+	}
+}
+
+func influxWrite(bucketID string, measurement string, path string) string {
+	return fmt.Sprintf(`
+		data "influxdb2_organization" "initial" {
+			name = "%s"
+		}
+		resource "influxdb2_write" "seed" {
+			org_id      = data.influxdb2_organization.initial.id
+			bucket_id   = "%s"
+			source_file = "%s"
+		}
+		resource "influxdb2_write" "points" {
+			org_id    = data.influxdb2_organization.initial.id
+			bucket_id = "%s"
+
+			point {
+				measurement   = "%s"
+				tags          = { host = "b" }
+				string_fields = { owner = "infra team" }
+			}
+		}
+`, testInitialOrg, bucketID, path, bucketID, measurement)
+}
+
+func TestAccResourceWrite(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+	measurement := acctest.RandomWithPrefix("test_write")
+	path := filepath.Join(t.TempDir(), "seed.lp")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(path, []byte(measurement+",host=a value=1\n"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testConfig(influxWrite(bucketID, measurement, path)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb2_write.seed", "content_sha256"),
+					testAccResourceWriteQuery(provider, measurement, "a", "1"),
+					testAccResourceWriteQuery(provider, measurement, "b", "infra team"),
+				),
+			},
+			{
+				// a change to the file re-writes it
+				PreConfig: func() {
+					if err := ioutil.WriteFile(path, []byte(measurement+",host=a value=2\n"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testConfig(influxWrite(bucketID, measurement, path)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceWriteQuery(provider, measurement, "a", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceWritePointFromResource(t *testing.T) {
+	bucketID := testAccInitialBucketID(t)
+	measurement := acctest.RandomWithPrefix("test_write")
+	org := acctest.RandomWithPrefix("test-org")
+
+	var provider *schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories(&provider),
+		Steps: []resource.TestStep{
+			{
+				// the field is only known once the Organization is created
+				Config: testConfig(fmt.Sprintf(`
+					data "influxdb2_organization" "initial" {
+						name = "%s"
+					}
+					resource "influxdb2_organization" "org" {
+						name = "%s"
+					}
+					resource "influxdb2_write" "points" {
+						org_id    = data.influxdb2_organization.initial.id
+						bucket_id = "%s"
+
+						point {
+							measurement   = "%s"
+							tags          = { host = "c" }
+							string_fields = { org_id = influxdb2_organization.org.id }
+						}
+					}
+				`, testInitialOrg, org, bucketID, measurement)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb2_write.points", "content_sha256"),
+					func(s *terraform.State) error {
+						orgID := s.RootModule().Resources["influxdb2_organization.org"].Primary.ID
+						return testAccResourceWriteQuery(provider, measurement, "c", orgID)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceWriteQuery checks the last value written for a host.
+func testAccResourceWriteQuery(testProvider *schema.Provider, measurement string, host string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		api := testProvider.Meta().(*metaData).api
+
+		orgID := s.RootModule().Resources["data.influxdb2_organization.initial"].Primary.ID
+		raw, err := api.query(context.Background(), orgID, fmt.Sprintf(
+			`from(bucket: "%s") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "%s" and r.host == "%s") |> last()`,
+			testInitialBucket, measurement, host))
+		if err != nil {
+			return err
+		}
+		rows, err := parseAnnotatedCSV(raw)
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 || rows[0]["_value"] != value {
+			return fmt.Errorf("expected a single row with value %q for host %q, got %v", value, host, rows)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// defaultMaxRetries & defaultMaxBackoff are used unless the provider max_retries and
+// max_backoff arguments are set.
+const (
+	defaultMaxRetries = 4
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// retryBaseWait is the wait before the first retry, doubled for every following one.
+var retryBaseWait = 1 * time.Second
+
+// isRetryable reports whether a request that failed with statusCode may be sent again.
+// InfluxDB Cloud returns 429 Too Many Requests once an org exceeds its read or write quota,
+// and 503 Service Unavailable during maintenance; in both cases the request wasn't processed.
+// 502 & 504 come from the gateways in front of InfluxDB, which may have forwarded the
+// request already, so they are only retried for idempotent methods.
+func isRetryable(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// retryWait returns how long to wait before retrying a request for the attempt-th time. The
+// Retry-After of the response is honored when present, otherwise the wait backs off
+// exponentially. Either way it is capped at maxBackoff, so a misbehaving server can't stall
+// an apply indefinitely.
+func (c *apiClient) retryWait(apiErr *apiError, attempt int) time.Duration {
+	wait := apiErr.RetryAfter
+	if wait <= 0 {
+		wait = retryBaseWait
+		for i := 1; i < attempt && wait < c.maxBackoff; i++ {
+			wait *= 2
+		}
+	}
+	if wait > c.maxBackoff {
+		wait = c.maxBackoff
+	}
+	return wait
+}
+
+// rewind returns a copy of a sent request that can be sent again. Requests with a body that
+// can't be read again, e.g. a streamed backup, can't be rewound.
+func rewind(req *http.Request) (*http.Request, error) {
+	again := req.Clone(req.Context())
+	// the HTTP client added the cookies of its jar to the request, they are added again
+	again.Header.Del("Cookie")
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("the request body can't be sent again")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		again.Body = body
+	}
+	return again, nil
+}
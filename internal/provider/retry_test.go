@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSendRetriesRateLimit(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if body, _ := ioutil.ReadAll(r.Body); string(body) != `{"query":"buckets()"}` {
+			t.Errorf("expected the body to be sent again, got %q", body)
+		}
+		if calls < 3 {
+			w.Header().Set("Retry-After", "120")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"code":"too many requests","message":"org exceeded read limit"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := newAPIClient(srv.URL, "token")
+	c.maxBackoff = 10 * time.Millisecond
+	err := c.doJSON(context.Background(), http.MethodPost, "/api/v2/query", nil, map[string]string{"query": "buckets()"}, nil)
+	if err != nil {
+		t.Fatalf("expected success after retries, got: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestSendRetriesGiveUp(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := newAPIClient(srv.URL, "token")
+	c.maxBackoff = time.Millisecond
+	err := c.doJSON(context.Background(), http.MethodGet, "/api/v2/buckets", nil, nil, nil)
+
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the last 503 error, got: %v", err)
+	}
+	if calls != defaultMaxRetries+1 {
+		t.Fatalf("expected %d calls, got %d", defaultMaxRetries+1, calls)
+	}
+}
+
+func TestSendRetriesOnlyTransientErrors(t *testing.T) {
+	for _, tc := range []struct {
+		method     string
+		statusCode int
+		calls      int
+	}{
+		{http.MethodGet, http.StatusBadRequest, 1},
+		{http.MethodGet, http.StatusInternalServerError, 1},
+		{http.MethodGet, http.StatusBadGateway, 3},
+		{http.MethodDelete, http.StatusGatewayTimeout, 3},
+		// the gateway may have forwarded the creation already
+		{http.MethodPost, http.StatusBadGateway, 1},
+		{http.MethodPost, http.StatusServiceUnavailable, 3},
+	} {
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(tc.statusCode)
+		}))
+
+		c := newAPIClient(srv.URL, "token")
+		c.maxRetries = 2
+		c.maxBackoff = time.Millisecond
+		if err := c.doJSON(context.Background(), tc.method, "/api/v2/buckets", nil, nil, nil); err == nil {
+			t.Errorf("%s %d: expected an error", tc.method, tc.statusCode)
+		}
+		if calls != tc.calls {
+			t.Errorf("%s %d: expected %d calls, got %d", tc.method, tc.statusCode, tc.calls, calls)
+		}
+		srv.Close()
+	}
+}
+
+func TestSendRetriesNotRewindable(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := newAPIClient(srv.URL, "token")
+	c.maxBackoff = time.Millisecond
+	// a streamed body, like the one of a restore, can't be read again
+	req, err := c.newRequest(context.Background(), http.MethodPost, "/api/v2/restore/kv", nil, ioutil.NopCloser(strings.NewReader("kv")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.send(req); err == nil || calls != 1 {
+		t.Fatalf("expected a single failed call, got %d calls and err: %v", calls, err)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := newAPIClient("http://localhost:8086", "token")
+	c.maxBackoff = 5 * time.Second
+
+	for _, tc := range []struct {
+		retryAfter time.Duration
+		attempt    int
+		want       time.Duration
+	}{
+		{0, 1, time.Second},
+		{0, 2, 2 * time.Second},
+		{0, 3, 4 * time.Second},
+		{0, 4, 5 * time.Second},
+		{0, 100, 5 * time.Second},
+		{3 * time.Second, 1, 3 * time.Second},
+		{time.Hour, 1, 5 * time.Second},
+	} {
+		if got := c.retryWait(&apiError{RetryAfter: tc.retryAfter}, tc.attempt); got != tc.want {
+			t.Errorf("retry-after %s, attempt %d: expected %s, got %s", tc.retryAfter, tc.attempt, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rollback undoes the API calls of a create that makes several of them, when a later call
+// fails, so a partial failure doesn't leave behind objects that Terraform doesn't manage and
+// that block the next apply, e.g. with a name conflict.
+//
+// The resource ID must be set as soon as the first object exists: when every call is
+// undone the ID is cleared, otherwise the resource is kept in state, tainted, so the next
+// apply destroys what is left.
+type rollback struct {
+	d     *schema.ResourceData
+	steps []rollbackStep
+}
+
+type rollbackStep struct {
+	desc string
+	undo func(ctx context.Context) error
+}
+
+func newRollback(d *schema.ResourceData) *rollback {
+	return &rollback{d: d}
+}
+
+// add records how to undo a successful call, e.g. add("Organization (<id>)", deleteOrg).
+func (r *rollback) add(desc string, undo func(ctx context.Context) error) {
+	r.steps = append(r.steps, rollbackStep{desc: desc, undo: undo})
+}
+
+// run undoes the recorded calls in reverse order, and returns the diagnostics of the failed
+// create with an error for every object that couldn't be deleted.
+func (r *rollback) run(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	undone := true
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		s := r.steps[i]
+		log.Printf("[INFO] Rolling back %s", s.desc)
+		if err := s.undo(ctx); err != nil && !isNotFound(err) {
+			undone = false
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("unable to roll back %s", s.desc),
+				Detail:   fmt.Sprintf("%s was created before the create failed, and is kept in state to be destroyed by the next apply: %v", s.desc, err),
+			})
+			continue
+		}
+		log.Printf("[INFO] Rolled back %s", s.desc)
+	}
+	r.steps = nil
+
+	if undone {
+		r.d.SetId("")
+	}
+	return diags
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRollback(t *testing.T) {
+	cases := []struct {
+		name    string
+		failing string
+		id      string
+		errors  int
+	}{
+		{"all undone", "", "", 1},
+		{"undo failed", "second", "0000000000000001", 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId("0000000000000001")
+
+			var undone []string
+			rb := newRollback(d)
+			for _, name := range []string{"first", "second", "not found"} {
+				name := name
+				rb.add(name, func(ctx context.Context) error {
+					undone = append(undone, name)
+					switch {
+					case name == "not found":
+						return &apiError{StatusCode: http.StatusNotFound}
+					case name == c.failing:
+						return fmt.Errorf("boom")
+					}
+					return nil
+				})
+			}
+
+			diags := rb.run(context.Background(), diag.Errorf("create failed"))
+			if expected := []string{"not found", "second", "first"}; !reflect.DeepEqual(undone, expected) {
+				t.Errorf("expected undo order %v, got %v", expected, undone)
+			}
+			if len(diags) != c.errors {
+				t.Errorf("expected %d errors, got: %v", c.errors, diags)
+			}
+			if d.Id() != c.id {
+				t.Errorf("expected ID %q, got %q", c.id, d.Id())
+			}
+		})
+	}
+}
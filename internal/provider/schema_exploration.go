@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The schema exploration data sources (influxdb2_measurements, influxdb2_tag_keys, ...) list
+// the schema of the data in a bucket with the Flux `influxdata/influxdb/schema` package.
+// Like the package, they only look at the data written after range_start.
+
+// schemaExplorationInputs returns the inputs shared by the schema exploration data sources.
+func schemaExplorationInputs() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Required inputs
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateStringNotEmpty,
+			Description:      "Name of the bucket.",
+		},
+		// Optional inputs
+		"org_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "ID of the Organization that owns the bucket. Defaults to the `org_id` of the provider.",
+		},
+		"range_start": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "-30d",
+			ValidateDiagFunc: validateFluxNegativeDuration,
+			Description:      "How far back to look at the data, as a negative Flux duration, e.g. `-1h` or `-1y`.",
+		},
+	}
+}
+
+// schemaMeasurementInput returns the optional input restricting a schema exploration data
+// source to a measurement.
+func schemaMeasurementInput(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only list the " + what + " of this measurement.",
+	}
+}
+
+// schemaPredicate returns the `predicate` argument of a Flux schema function restricting it
+// to the measurement in the configuration, if any.
+func schemaPredicate(d *schema.ResourceData) string {
+	if m, ok := d.GetOk("measurement"); ok {
+		return fmt.Sprintf(", predicate: (r) => r._measurement == %s", fluxString(m.(string)))
+	}
+	return ""
+}
+
+var fluxNegativeDurationRegexp = regexp.MustCompile(`^-(\d+(ns|us|µs|ms|s|mo|m|h|d|w|y))+$`)
+
+// validateFluxNegativeDuration ensures a string is a negative Flux duration literal, so it
+// can be used in a query as is.
+func validateFluxNegativeDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if !fluxNegativeDurationRegexp.MatchString(v.(string)) {
+		msg := "must be a negative Flux duration, e.g. -30d"
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       msg,
+			Detail:        msg,
+			AttributePath: path,
+		})
+	}
+
+	return diagnostics
+}
+
+// querySchemaValues runs a query of the Flux schema package, e.g. `schema.measurements()`,
+// and returns the distinct values of its `_value` column, sorted.
+func (c *apiClient) querySchemaValues(ctx context.Context, orgID string, call string) ([]string, error) {
+	raw, err := c.query(ctx, orgID, "import \"influxdata/influxdb/schema\"\n\n"+call)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := parseAnnotatedCSV(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	values := []string{}
+	for _, row := range rows {
+		if v := row["_value"]; !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values, nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTransport returns the HTTP transport of the API client, configured by the TLS &
+// connection arguments of the provider.
+func newTransport(d *schema.ResourceData) (http.RoundTripper, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+
+	caCert, err := pemArgument(d, "ca_cert_pem", "ca_cert_file")
+	if err != nil {
+		return nil, err
+	}
+	if caCert != nil {
+		// the CA is trusted in addition to the system ones
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no PEM encoded certificate found in the CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, err := pemArgument(d, "client_cert_pem", "client_cert_file")
+	if err != nil {
+		return nil, err
+	}
+	clientKey, err := pemArgument(d, "client_key_pem", "client_key_file")
+	if err != nil {
+		return nil, err
+	}
+	if (clientCert == nil) != (clientKey == nil) {
+		return nil, fmt.Errorf("the client certificate and its key must be set together")
+	}
+	if clientCert != nil {
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	// like the default transport, the proxy is taken from the HTTP_PROXY, HTTPS_PROXY &
+	// NO_PROXY environment variables unless proxy_url is set
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tlsConfig
+	if v, ok := d.GetOk("proxy_url"); ok {
+		proxyURL, err := url.Parse(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %v", err)
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+	return t, nil
+}
+
+// pemArgument returns the PEM contents set with either an argument or a file path argument,
+// or nil when neither is set.
+func pemArgument(d *schema.ResourceData, pemKey string, fileKey string) ([]byte, error) {
+	if v, ok := d.GetOk(pemKey); ok {
+		return []byte(v.(string)), nil
+	}
+	if v, ok := d.GetOk(fileKey); ok {
+		b, err := ioutil.ReadFile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %v", fileKey, err)
+		}
+		return b, nil
+	}
+	return nil, nil
+}
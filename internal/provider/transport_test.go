@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testTransportAPIClient returns an API client for srv, with the transport configured by the
+// given provider arguments.
+func testTransportAPIClient(t *testing.T, srv *httptest.Server, config map[string]interface{}) *apiClient {
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, config)
+	transport, err := newTransport(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := newAPIClient(srv.URL, "token")
+	api.httpClient.Transport = transport
+	return api
+}
+
+func TestTransportInsecureSkipVerify(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	if _, err := testTransportAPIClient(t, srv, map[string]interface{}{}).ready(context.Background()); err == nil {
+		t.Fatal("expected the self-signed certificate to be rejected")
+	}
+	if _, err := testTransportAPIClient(t, srv, map[string]interface{}{"insecure_skip_verify": true}).ready(context.Background()); err != nil {
+		t.Fatalf("expected the certificate verification to be skipped, got: %v", err)
+	}
+}
+
+func TestTransportCACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte(caCert), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, config := range map[string]map[string]interface{}{
+		"pem":  {"ca_cert_pem": caCert},
+		"file": {"ca_cert_file": caFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := testTransportAPIClient(t, srv, config).ready(context.Background()); err != nil {
+				t.Fatalf("expected the CA to be trusted, got: %v", err)
+			}
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]interface{}{"ca_cert_pem": "not a certificate"})
+	if _, err := newTransport(d); err == nil || !strings.Contains(err.Error(), "no PEM encoded certificate") {
+		t.Fatalf("expected an invalid CA certificate to be rejected, got: %v", err)
+	}
+}
+
+// testClientCertificate returns a self-signed client certificate and its key, PEM encoded.
+func testClientCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		cert
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	clientCert, clientKey, cert := testClientCertificate(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready"}`))
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	keyFile := filepath.Join(t.TempDir(), "client.key")
+	if err := ioutil.WriteFile(keyFile, []byte(clientKey), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := map[string]interface{}{"insecure_skip_verify": true}
+	if _, err := testTransportAPIClient(t, srv, config).ready(context.Background()); err == nil {
+		t.Fatal("expected the server to require a client certificate")
+	}
+	config["client_cert_pem"] = clientCert
+	config["client_key_file"] = keyFile
+	if _, err := testTransportAPIClient(t, srv, config).ready(context.Background()); err != nil {
+		t.Fatalf("expected the client certificate to be accepted, got: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]interface{}{"client_cert_pem": clientCert})
+	if _, err := newTransport(d); err == nil || !strings.Contains(err.Error(), "must be set together") {
+		t.Fatalf("expected a client certificate without a key to be rejected, got: %v", err)
+	}
+}
+
+func TestTransportProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ready"}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]interface{}{"proxy_url": proxy.URL})
+	transport, err := newTransport(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := newAPIClient("http://influxdb.example.com:8086", "token")
+	api.httpClient.Transport = transport
+
+	if _, err := api.ready(context.Background()); err != nil {
+		t.Fatalf("expected the request to go through the proxy, got: %v", err)
+	}
+	if proxied != "http://influxdb.example.com:8086/ready" {
+		t.Errorf("expected the proxy to receive the request for the host, got %q", proxied)
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -70,3 +72,74 @@ func validateSemVer(v interface{}, path cty.Path) diag.Diagnostics {
 
 	return diagnostics
 }
+
+// validateIntBetween returns a func which ensures the int value is between min and max, inclusive.
+func validateIntBetween(min, max int) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diagnostics diag.Diagnostics
+
+		value := v.(int)
+		if value < min || value > max {
+			msg := fmt.Sprintf("expected %d to be between %d and %d", value, min, max)
+			diagnostics = append(diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       msg,
+				Detail:        msg,
+				AttributePath: path,
+			})
+		}
+
+		return diagnostics
+	}
+}
+
+// validateRFC3339 ensures a specified string is an RFC3339 timestamp.
+func validateRFC3339(v interface{}, path cty.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		msg := fmt.Sprintf("must be an RFC3339 timestamp: %v", err)
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       msg,
+			Detail:        msg,
+			AttributePath: path,
+		})
+	}
+
+	return diagnostics
+}
+
+// validateURL ensures a specified string is an absolute URL, with a scheme & host.
+func validateURL(v interface{}, path cty.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if u, err := url.Parse(v.(string)); err != nil || u.Scheme == "" || u.Host == "" {
+		msg := "must be an absolute URL, e.g. http://proxy.example.com:3128"
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       msg,
+			Detail:        msg,
+			AttributePath: path,
+		})
+	}
+
+	return diagnostics
+}
+
+// validateDuration ensures a specified string is a positive Go duration, e.g. 30s or 2m.
+func validateDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if d, err := time.ParseDuration(v.(string)); err != nil || d <= 0 {
+		msg := "must be a positive duration, e.g. 30s or 2m"
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       msg,
+			Detail:        msg,
+			AttributePath: path,
+		})
+	}
+
+	return diagnostics
+}